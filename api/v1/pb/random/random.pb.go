@@ -8,6 +8,7 @@ package random
 
 import (
 	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -22,9 +23,329 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Mode int32
+
+const (
+	// Unspecified behaves as MODE_SEEDED.
+	Mode_MODE_UNSPECIFIED Mode = 0
+	// Values are reproducible from SeedNum.
+	Mode_MODE_SEEDED Mode = 1
+	// Values come from a cryptographically secure source, SeedNum is ignored.
+	Mode_MODE_SECURE Mode = 2
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "MODE_SEEDED",
+		2: "MODE_SECURE",
+	}
+	Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"MODE_SEEDED":      1,
+		"MODE_SECURE":      2,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[0].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[0]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{0}
+}
+
+type Encoding int32
+
+const (
+	// Unspecified behaves as ENCODING_HEX.
+	Encoding_ENCODING_UNSPECIFIED Encoding = 0
+	Encoding_ENCODING_HEX         Encoding = 1
+	// Standard base64 with padding.
+	Encoding_ENCODING_BASE64 Encoding = 2
+)
+
+// Enum value maps for Encoding.
+var (
+	Encoding_name = map[int32]string{
+		0: "ENCODING_UNSPECIFIED",
+		1: "ENCODING_HEX",
+		2: "ENCODING_BASE64",
+	}
+	Encoding_value = map[string]int32{
+		"ENCODING_UNSPECIFIED": 0,
+		"ENCODING_HEX":         1,
+		"ENCODING_BASE64":      2,
+	}
+)
+
+func (x Encoding) Enum() *Encoding {
+	p := new(Encoding)
+	*p = x
+	return p
+}
+
+func (x Encoding) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Encoding) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[1].Descriptor()
+}
+
+func (Encoding) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[1]
+}
+
+func (x Encoding) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Encoding.Descriptor instead.
+func (Encoding) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{1}
+}
+
+type UUIDVersion int32
+
+const (
+	// Unspecified behaves as UUID_VERSION_4.
+	UUIDVersion_UUID_VERSION_UNSPECIFIED UUIDVersion = 0
+	UUIDVersion_UUID_VERSION_4           UUIDVersion = 4
+	// Time-ordered, the timestamp comes from the server clock even in seeded mode.
+	UUIDVersion_UUID_VERSION_7 UUIDVersion = 7
+)
+
+// Enum value maps for UUIDVersion.
+var (
+	UUIDVersion_name = map[int32]string{
+		0: "UUID_VERSION_UNSPECIFIED",
+		4: "UUID_VERSION_4",
+		7: "UUID_VERSION_7",
+	}
+	UUIDVersion_value = map[string]int32{
+		"UUID_VERSION_UNSPECIFIED": 0,
+		"UUID_VERSION_4":           4,
+		"UUID_VERSION_7":           7,
+	}
+)
+
+func (x UUIDVersion) Enum() *UUIDVersion {
+	p := new(UUIDVersion)
+	*p = x
+	return p
+}
+
+func (x UUIDVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UUIDVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[2].Descriptor()
+}
+
+func (UUIDVersion) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[2]
+}
+
+func (x UUIDVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UUIDVersion.Descriptor instead.
+func (UUIDVersion) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{2}
+}
+
+type JobState int32
+
+const (
+	JobState_JOB_STATE_UNSPECIFIED JobState = 0
+	JobState_JOB_STATE_QUEUED      JobState = 1
+	JobState_JOB_STATE_RUNNING     JobState = 2
+	JobState_JOB_STATE_SUCCEEDED   JobState = 3
+	JobState_JOB_STATE_FAILED      JobState = 4
+	JobState_JOB_STATE_CANCELLED   JobState = 5
+)
+
+// Enum value maps for JobState.
+var (
+	JobState_name = map[int32]string{
+		0: "JOB_STATE_UNSPECIFIED",
+		1: "JOB_STATE_QUEUED",
+		2: "JOB_STATE_RUNNING",
+		3: "JOB_STATE_SUCCEEDED",
+		4: "JOB_STATE_FAILED",
+		5: "JOB_STATE_CANCELLED",
+	}
+	JobState_value = map[string]int32{
+		"JOB_STATE_UNSPECIFIED": 0,
+		"JOB_STATE_QUEUED":      1,
+		"JOB_STATE_RUNNING":     2,
+		"JOB_STATE_SUCCEEDED":   3,
+		"JOB_STATE_FAILED":      4,
+		"JOB_STATE_CANCELLED":   5,
+	}
+)
+
+func (x JobState) Enum() *JobState {
+	p := new(JobState)
+	*p = x
+	return p
+}
+
+func (x JobState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (JobState) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[3].Descriptor()
+}
+
+func (JobState) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[3]
+}
+
+func (x JobState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use JobState.Descriptor instead.
+func (JobState) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{3}
+}
+
+type ExportFormat int32
+
+const (
+	// Unspecified exports CSV.
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// CSV has a header row, then one row per draw with Output as a JSON string.
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 1
+	// NDJSON has one JSON object per draw and line, with Output embedded.
+	ExportFormat_EXPORT_FORMAT_NDJSON ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_NDJSON",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_NDJSON":      2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[4].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[4]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{4}
+}
+
+type QuotaWindow int32
+
+const (
+	QuotaWindow_QUOTA_WINDOW_UNSPECIFIED QuotaWindow = 0
+	// Daily windows start at midnight UTC.
+	QuotaWindow_QUOTA_WINDOW_DAILY QuotaWindow = 1
+	// Monthly windows start on the first of the month at midnight UTC.
+	QuotaWindow_QUOTA_WINDOW_MONTHLY QuotaWindow = 2
+)
+
+// Enum value maps for QuotaWindow.
+var (
+	QuotaWindow_name = map[int32]string{
+		0: "QUOTA_WINDOW_UNSPECIFIED",
+		1: "QUOTA_WINDOW_DAILY",
+		2: "QUOTA_WINDOW_MONTHLY",
+	}
+	QuotaWindow_value = map[string]int32{
+		"QUOTA_WINDOW_UNSPECIFIED": 0,
+		"QUOTA_WINDOW_DAILY":       1,
+		"QUOTA_WINDOW_MONTHLY":     2,
+	}
+)
+
+func (x QuotaWindow) Enum() *QuotaWindow {
+	p := new(QuotaWindow)
+	*p = x
+	return p
+}
+
+func (x QuotaWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuotaWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v1_pb_random_random_proto_enumTypes[5].Descriptor()
+}
+
+func (QuotaWindow) Type() protoreflect.EnumType {
+	return &file_api_v1_pb_random_random_proto_enumTypes[5]
+}
+
+func (x QuotaWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuotaWindow.Descriptor instead.
+func (QuotaWindow) EnumDescriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{5}
+}
+
 type GetRandNumberRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SeedNum       int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Mode    Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	// Algorithm is the seeded generator: "legacy", "pcg", "chacha8" or
+	// "xoshiro256**", unless disabled by the server. Empty selects the server's
+	// default, it must be empty in MODE_SECURE.
+	Algorithm     string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,9 +387,31 @@ func (x *GetRandNumberRequest) GetSeedNum() int64 {
 	return 0
 }
 
+func (x *GetRandNumberRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetRandNumberRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
 type GetRandNumberReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Number        int64                  `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Number int64                  `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	// Mode is the mode that produced Number.
+	Mode Mode `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	// Algorithm and AlgorithmVersion identify the generator that produced Number,
+	// the same seed, algorithm and version always produce the same values.
+	Algorithm        string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,82 +453,6974 @@ func (x *GetRandNumberReply) GetNumber() int64 {
 	return 0
 }
 
-var File_api_v1_pb_random_random_proto protoreflect.FileDescriptor
+func (x *GetRandNumberReply) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
 
-var file_api_v1_pb_random_random_proto_rawDesc = string([]byte{
-	0x0a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x39, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07,
-	0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba,
-	0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x22,
-	0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x5c, 0x0a,
-	0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4b,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
-	0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
-	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x97, 0x01, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x0b, 0x52, 0x61, 0x6e, 0x64,
-	0x6f, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6e, 0x68, 0x74, 0x68, 0x6f, 0x6e, 0x67, 0x35,
-	0x38, 0x32, 0x30, 0x30, 0x30, 0x2f, 0x73, 0x6f, 0x61, 0x2d, 0x34, 0x30, 0x34, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0xa2,
-	0x02, 0x03, 0x52, 0x58, 0x58, 0xaa, 0x02, 0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0xca, 0x02,
-	0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0xe2, 0x02, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-})
+func (x *GetRandNumberReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
 
-var (
-	file_api_v1_pb_random_random_proto_rawDescOnce sync.Once
-	file_api_v1_pb_random_random_proto_rawDescData []byte
-)
+func (x *GetRandNumberReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
 
-func file_api_v1_pb_random_random_proto_rawDescGZIP() []byte {
-	file_api_v1_pb_random_random_proto_rawDescOnce.Do(func() {
-		file_api_v1_pb_random_random_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_pb_random_random_proto_rawDesc), len(file_api_v1_pb_random_random_proto_rawDesc)))
-	})
-	return file_api_v1_pb_random_random_proto_rawDescData
+func (x *GetRandNumberReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
 }
 
-var file_api_v1_pb_random_random_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_api_v1_pb_random_random_proto_goTypes = []any{
-	(*GetRandNumberRequest)(nil), // 0: random.GetRandNumberRequest
-	(*GetRandNumberReply)(nil),   // 1: random.GetRandNumberReply
+// Signature is the Ed25519 signature of a random output. It covers the key ID,
+// the seed (0 in MODE_SECURE), the mode, the algorithm and its version, the
+// range, the output and the timestamp, see pkg/signing for the encoding. The
+// output of GetRandNumber and GetRandNumberInRange is the value they return,
+// the output of the other draw replies is the reply itself, see
+// signing.Message.
+type Signature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyID string                 `protobuf:"bytes,1,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	// Timestamp is the Unix time in nanoseconds at which the output was signed.
+	Timestamp     int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	Value         []byte `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
-var file_api_v1_pb_random_random_proto_depIdxs = []int32{
-	0, // 0: random.RandomService.GetRandNumber:input_type -> random.GetRandNumberRequest
-	1, // 1: random.RandomService.GetRandNumber:output_type -> random.GetRandNumberReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *Signature) Reset() {
+	*x = Signature{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func init() { file_api_v1_pb_random_random_proto_init() }
-func file_api_v1_pb_random_random_proto_init() {
-	if File_api_v1_pb_random_random_proto != nil {
-		return
+func (x *Signature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Signature) ProtoMessage() {}
+
+func (x *Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Signature.ProtoReflect.Descriptor instead.
+func (*Signature) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Signature) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *Signature) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Signature) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type StreamRandNumbersRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Count is the number of values to stream, 0 streams until the client cancels.
+	Count int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRandNumbersRequest) Reset() {
+	*x = StreamRandNumbersRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRandNumbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRandNumbersRequest) ProtoMessage() {}
+
+func (x *StreamRandNumbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRandNumbersRequest.ProtoReflect.Descriptor instead.
+func (*StreamRandNumbersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamRandNumbersRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *StreamRandNumbersRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *StreamRandNumbersRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type StreamRandNumbersReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Index is the position of Number in the sequence, the first value has index 0.
+	Index            int64  `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	Number           int64  `protobuf:"varint,2,opt,name=Number,proto3" json:"Number,omitempty"`
+	Algorithm        string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamRandNumbersReply) Reset() {
+	*x = StreamRandNumbersReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamRandNumbersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamRandNumbersReply) ProtoMessage() {}
+
+func (x *StreamRandNumbersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamRandNumbersReply.ProtoReflect.Descriptor instead.
+func (*StreamRandNumbersReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StreamRandNumbersReply) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *StreamRandNumbersReply) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *StreamRandNumbersReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *StreamRandNumbersReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *StreamRandNumbersReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetRandNumbersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server may enforce a lower limit through its configuration.
+	SeedNums []int64 `protobuf:"varint,1,rep,packed,name=SeedNums,proto3" json:"SeedNums,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumbersRequest) Reset() {
+	*x = GetRandNumbersRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumbersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumbersRequest) ProtoMessage() {}
+
+func (x *GetRandNumbersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumbersRequest.ProtoReflect.Descriptor instead.
+func (*GetRandNumbersRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetRandNumbersRequest) GetSeedNums() []int64 {
+	if x != nil {
+		return x.SeedNums
+	}
+	return nil
+}
+
+func (x *GetRandNumbersRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type GetRandNumbersReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Results are in the same order as the requested seeds.
+	Results          []*RandNumberResult `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	Algorithm        string              `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string              `protobuf:"bytes,3,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumbersReply) Reset() {
+	*x = GetRandNumbersReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumbersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumbersReply) ProtoMessage() {}
+
+func (x *GetRandNumbersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumbersReply.ProtoReflect.Descriptor instead.
+func (*GetRandNumbersReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetRandNumbersReply) GetResults() []*RandNumberResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *GetRandNumbersReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetRandNumbersReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetRandNumbersReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type RandNumberResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Types that are valid to be assigned to Result:
+	//
+	//	*RandNumberResult_Number
+	//	*RandNumberResult_Error
+	Result        isRandNumberResult_Result `protobuf_oneof:"Result"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RandNumberResult) Reset() {
+	*x = RandNumberResult{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RandNumberResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RandNumberResult) ProtoMessage() {}
+
+func (x *RandNumberResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RandNumberResult.ProtoReflect.Descriptor instead.
+func (*RandNumberResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RandNumberResult) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *RandNumberResult) GetResult() isRandNumberResult_Result {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RandNumberResult) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Result.(*RandNumberResult_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *RandNumberResult) GetError() *Status {
+	if x != nil {
+		if x, ok := x.Result.(*RandNumberResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isRandNumberResult_Result interface {
+	isRandNumberResult_Result()
+}
+
+type RandNumberResult_Number struct {
+	Number int64 `protobuf:"varint,2,opt,name=Number,proto3,oneof"`
+}
+
+type RandNumberResult_Error struct {
+	Error *Status `protobuf:"bytes,3,opt,name=Error,proto3,oneof"`
+}
+
+func (*RandNumberResult_Number) isRandNumberResult_Result() {}
+
+func (*RandNumberResult_Error) isRandNumberResult_Result() {}
+
+// Status is the error of a single item, Code is a gRPC status code.
+type Status struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=Message,proto3" json:"Message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Status) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *Status) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetRandNumberInRangeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Types that are valid to be assigned to Range:
+	//
+	//	*GetRandNumberInRangeRequest_IntRange
+	//	*GetRandNumberInRangeRequest_FloatRange
+	Range isGetRandNumberInRangeRequest_Range `protobuf_oneof:"Range"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumberInRangeRequest) Reset() {
+	*x = GetRandNumberInRangeRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumberInRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumberInRangeRequest) ProtoMessage() {}
+
+func (x *GetRandNumberInRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumberInRangeRequest.ProtoReflect.Descriptor instead.
+func (*GetRandNumberInRangeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRandNumberInRangeRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetRandNumberInRangeRequest) GetRange() isGetRandNumberInRangeRequest_Range {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *GetRandNumberInRangeRequest) GetIntRange() *IntRange {
+	if x != nil {
+		if x, ok := x.Range.(*GetRandNumberInRangeRequest_IntRange); ok {
+			return x.IntRange
+		}
+	}
+	return nil
+}
+
+func (x *GetRandNumberInRangeRequest) GetFloatRange() *FloatRange {
+	if x != nil {
+		if x, ok := x.Range.(*GetRandNumberInRangeRequest_FloatRange); ok {
+			return x.FloatRange
+		}
+	}
+	return nil
+}
+
+func (x *GetRandNumberInRangeRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type isGetRandNumberInRangeRequest_Range interface {
+	isGetRandNumberInRangeRequest_Range()
+}
+
+type GetRandNumberInRangeRequest_IntRange struct {
+	IntRange *IntRange `protobuf:"bytes,2,opt,name=IntRange,proto3,oneof"`
+}
+
+type GetRandNumberInRangeRequest_FloatRange struct {
+	FloatRange *FloatRange `protobuf:"bytes,3,opt,name=FloatRange,proto3,oneof"`
+}
+
+func (*GetRandNumberInRangeRequest_IntRange) isGetRandNumberInRangeRequest_Range() {}
+
+func (*GetRandNumberInRangeRequest_FloatRange) isGetRandNumberInRangeRequest_Range() {}
+
+type GetRandNumberInRangeReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*GetRandNumberInRangeReply_Number
+	//	*GetRandNumberInRangeReply_Float
+	Value            isGetRandNumberInRangeReply_Value `protobuf_oneof:"Value"`
+	Algorithm        string                            `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string                            `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumberInRangeReply) Reset() {
+	*x = GetRandNumberInRangeReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumberInRangeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumberInRangeReply) ProtoMessage() {}
+
+func (x *GetRandNumberInRangeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumberInRangeReply.ProtoReflect.Descriptor instead.
+func (*GetRandNumberInRangeReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRandNumberInRangeReply) GetValue() isGetRandNumberInRangeReply_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *GetRandNumberInRangeReply) GetNumber() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*GetRandNumberInRangeReply_Number); ok {
+			return x.Number
+		}
+	}
+	return 0
+}
+
+func (x *GetRandNumberInRangeReply) GetFloat() float64 {
+	if x != nil {
+		if x, ok := x.Value.(*GetRandNumberInRangeReply_Float); ok {
+			return x.Float
+		}
+	}
+	return 0
+}
+
+func (x *GetRandNumberInRangeReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetRandNumberInRangeReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetRandNumberInRangeReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type isGetRandNumberInRangeReply_Value interface {
+	isGetRandNumberInRangeReply_Value()
+}
+
+type GetRandNumberInRangeReply_Number struct {
+	Number int64 `protobuf:"varint,1,opt,name=Number,proto3,oneof"`
+}
+
+type GetRandNumberInRangeReply_Float struct {
+	Float float64 `protobuf:"fixed64,2,opt,name=Float,proto3,oneof"`
+}
+
+func (*GetRandNumberInRangeReply_Number) isGetRandNumberInRangeReply_Value() {}
+
+func (*GetRandNumberInRangeReply_Float) isGetRandNumberInRangeReply_Value() {}
+
+type WeightedChoiceRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Items   []*WeightedItem        `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+	// Count is the number of picks, with replacement. 0 returns one pick.
+	Count int64 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedChoiceRequest) Reset() {
+	*x = WeightedChoiceRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedChoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedChoiceRequest) ProtoMessage() {}
+
+func (x *WeightedChoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedChoiceRequest.ProtoReflect.Descriptor instead.
+func (*WeightedChoiceRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WeightedChoiceRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *WeightedChoiceRequest) GetItems() []*WeightedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *WeightedChoiceRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *WeightedChoiceRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+// WeightedItem is picked with a probability proportional to its Weight,
+// items with a Weight of 0 are never picked.
+type WeightedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=Value,proto3" json:"Value,omitempty"`
+	Weight        float64                `protobuf:"fixed64,2,opt,name=Weight,proto3" json:"Weight,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedItem) Reset() {
+	*x = WeightedItem{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedItem) ProtoMessage() {}
+
+func (x *WeightedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedItem.ProtoReflect.Descriptor instead.
+func (*WeightedItem) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WeightedItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *WeightedItem) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+type WeightedChoiceReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Items are the values of the picked items.
+	Items []string `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+	// Indices are the positions of the picked items in the request.
+	Indices          []int64 `protobuf:"varint,2,rep,packed,name=Indices,proto3" json:"Indices,omitempty"`
+	Algorithm        string  `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string  `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedChoiceReply) Reset() {
+	*x = WeightedChoiceReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedChoiceReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedChoiceReply) ProtoMessage() {}
+
+func (x *WeightedChoiceReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedChoiceReply.ProtoReflect.Descriptor instead.
+func (*WeightedChoiceReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WeightedChoiceReply) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *WeightedChoiceReply) GetIndices() []int64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *WeightedChoiceReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *WeightedChoiceReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *WeightedChoiceReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type RollRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Dice is the dice notation to roll, e.g. "3d6+2", "4d6kh3" or "2d10!-1".
+	// Terms are added or subtracted, "!" explodes the dice, kh/k and kl keep
+	// the highest or lowest dice, dh and dl drop them, and "d%" is a d100.
+	Dice string `protobuf:"bytes,2,opt,name=Dice,proto3" json:"Dice,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollRequest) Reset() {
+	*x = RollRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollRequest) ProtoMessage() {}
+
+func (x *RollRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollRequest.ProtoReflect.Descriptor instead.
+func (*RollRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RollRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *RollRequest) GetDice() string {
+	if x != nil {
+		return x.Dice
+	}
+	return ""
+}
+
+func (x *RollRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type RollReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Total is the sum of the kept dice and of Modifier.
+	Total int64 `protobuf:"varint,1,opt,name=Total,proto3" json:"Total,omitempty"`
+	// Modifier is the sum of the constant terms.
+	Modifier int64 `protobuf:"varint,2,opt,name=Modifier,proto3" json:"Modifier,omitempty"`
+	// Dice are every rolled die, in rolling order.
+	Dice             []*Die `protobuf:"bytes,3,rep,name=Dice,proto3" json:"Dice,omitempty"`
+	Algorithm        string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RollReply) Reset() {
+	*x = RollReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RollReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RollReply) ProtoMessage() {}
+
+func (x *RollReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RollReply.ProtoReflect.Descriptor instead.
+func (*RollReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RollReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *RollReply) GetModifier() int64 {
+	if x != nil {
+		return x.Modifier
+	}
+	return 0
+}
+
+func (x *RollReply) GetDice() []*Die {
+	if x != nil {
+		return x.Dice
+	}
+	return nil
+}
+
+func (x *RollReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *RollReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *RollReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type Die struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Term is the index of the die's dice term in the notation, starting at 0.
+	Term  int32 `protobuf:"varint,1,opt,name=Term,proto3" json:"Term,omitempty"`
+	Sides int64 `protobuf:"varint,2,opt,name=Sides,proto3" json:"Sides,omitempty"`
+	Value int64 `protobuf:"varint,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// Kept dice count towards the total.
+	Kept bool `protobuf:"varint,4,opt,name=Kept,proto3" json:"Kept,omitempty"`
+	// Exploded dice were rolled because the previous die showed its highest face.
+	Exploded      bool `protobuf:"varint,5,opt,name=Exploded,proto3" json:"Exploded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Die) Reset() {
+	*x = Die{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Die) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Die) ProtoMessage() {}
+
+func (x *Die) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Die.ProtoReflect.Descriptor instead.
+func (*Die) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Die) GetTerm() int32 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *Die) GetSides() int64 {
+	if x != nil {
+		return x.Sides
+	}
+	return 0
+}
+
+func (x *Die) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Die) GetKept() bool {
+	if x != nil {
+		return x.Kept
+	}
+	return false
+}
+
+func (x *Die) GetExploded() bool {
+	if x != nil {
+		return x.Exploded
+	}
+	return false
+}
+
+// IntRange is the closed interval [Min, Max].
+type IntRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           int64                  `protobuf:"varint,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max           int64                  `protobuf:"varint,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntRange) Reset() {
+	*x = IntRange{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntRange) ProtoMessage() {}
+
+func (x *IntRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntRange.ProtoReflect.Descriptor instead.
+func (*IntRange) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *IntRange) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *IntRange) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+// FloatRange is the half-open interval [Min, Max), or Min when both bounds are equal.
+type FloatRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float64                `protobuf:"fixed64,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max           float64                `protobuf:"fixed64,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FloatRange) Reset() {
+	*x = FloatRange{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FloatRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FloatRange) ProtoMessage() {}
+
+func (x *FloatRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FloatRange.ProtoReflect.Descriptor instead.
+func (*FloatRange) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *FloatRange) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *FloatRange) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type SampleDistributionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Count   int64                  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Types that are valid to be assigned to Distribution:
+	//
+	//	*SampleDistributionRequest_Normal
+	//	*SampleDistributionRequest_Exponential
+	//	*SampleDistributionRequest_Poisson
+	//	*SampleDistributionRequest_Binomial
+	//	*SampleDistributionRequest_Uniform
+	Distribution isSampleDistributionRequest_Distribution `protobuf_oneof:"Distribution"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,8,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleDistributionRequest) Reset() {
+	*x = SampleDistributionRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleDistributionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleDistributionRequest) ProtoMessage() {}
+
+func (x *SampleDistributionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleDistributionRequest.ProtoReflect.Descriptor instead.
+func (*SampleDistributionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SampleDistributionRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *SampleDistributionRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *SampleDistributionRequest) GetDistribution() isSampleDistributionRequest_Distribution {
+	if x != nil {
+		return x.Distribution
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetNormal() *NormalDistribution {
+	if x != nil {
+		if x, ok := x.Distribution.(*SampleDistributionRequest_Normal); ok {
+			return x.Normal
+		}
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetExponential() *ExponentialDistribution {
+	if x != nil {
+		if x, ok := x.Distribution.(*SampleDistributionRequest_Exponential); ok {
+			return x.Exponential
+		}
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetPoisson() *PoissonDistribution {
+	if x != nil {
+		if x, ok := x.Distribution.(*SampleDistributionRequest_Poisson); ok {
+			return x.Poisson
+		}
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetBinomial() *BinomialDistribution {
+	if x != nil {
+		if x, ok := x.Distribution.(*SampleDistributionRequest_Binomial); ok {
+			return x.Binomial
+		}
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetUniform() *FloatRange {
+	if x != nil {
+		if x, ok := x.Distribution.(*SampleDistributionRequest_Uniform); ok {
+			return x.Uniform
+		}
+	}
+	return nil
+}
+
+func (x *SampleDistributionRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type isSampleDistributionRequest_Distribution interface {
+	isSampleDistributionRequest_Distribution()
+}
+
+type SampleDistributionRequest_Normal struct {
+	Normal *NormalDistribution `protobuf:"bytes,3,opt,name=Normal,proto3,oneof"`
+}
+
+type SampleDistributionRequest_Exponential struct {
+	Exponential *ExponentialDistribution `protobuf:"bytes,4,opt,name=Exponential,proto3,oneof"`
+}
+
+type SampleDistributionRequest_Poisson struct {
+	Poisson *PoissonDistribution `protobuf:"bytes,5,opt,name=Poisson,proto3,oneof"`
+}
+
+type SampleDistributionRequest_Binomial struct {
+	Binomial *BinomialDistribution `protobuf:"bytes,6,opt,name=Binomial,proto3,oneof"`
+}
+
+type SampleDistributionRequest_Uniform struct {
+	Uniform *FloatRange `protobuf:"bytes,7,opt,name=Uniform,proto3,oneof"`
+}
+
+func (*SampleDistributionRequest_Normal) isSampleDistributionRequest_Distribution() {}
+
+func (*SampleDistributionRequest_Exponential) isSampleDistributionRequest_Distribution() {}
+
+func (*SampleDistributionRequest_Poisson) isSampleDistributionRequest_Distribution() {}
+
+func (*SampleDistributionRequest_Binomial) isSampleDistributionRequest_Distribution() {}
+
+func (*SampleDistributionRequest_Uniform) isSampleDistributionRequest_Distribution() {}
+
+type SampleDistributionReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Samples are drawn in order from the sequence of SeedNum. Poisson and
+	// binomial samples are whole numbers.
+	Samples          []float64 `protobuf:"fixed64,1,rep,packed,name=Samples,proto3" json:"Samples,omitempty"`
+	Algorithm        string    `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string    `protobuf:"bytes,3,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,4,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleDistributionReply) Reset() {
+	*x = SampleDistributionReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleDistributionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleDistributionReply) ProtoMessage() {}
+
+func (x *SampleDistributionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleDistributionReply.ProtoReflect.Descriptor instead.
+func (*SampleDistributionReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SampleDistributionReply) GetSamples() []float64 {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+func (x *SampleDistributionReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *SampleDistributionReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *SampleDistributionReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type NormalDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mean          float64                `protobuf:"fixed64,1,opt,name=Mean,proto3" json:"Mean,omitempty"`
+	StdDev        float64                `protobuf:"fixed64,2,opt,name=StdDev,proto3" json:"StdDev,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NormalDistribution) Reset() {
+	*x = NormalDistribution{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NormalDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NormalDistribution) ProtoMessage() {}
+
+func (x *NormalDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NormalDistribution.ProtoReflect.Descriptor instead.
+func (*NormalDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NormalDistribution) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *NormalDistribution) GetStdDev() float64 {
+	if x != nil {
+		return x.StdDev
+	}
+	return 0
+}
+
+type ExponentialDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          float64                `protobuf:"fixed64,1,opt,name=Rate,proto3" json:"Rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExponentialDistribution) Reset() {
+	*x = ExponentialDistribution{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExponentialDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExponentialDistribution) ProtoMessage() {}
+
+func (x *ExponentialDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExponentialDistribution.ProtoReflect.Descriptor instead.
+func (*ExponentialDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ExponentialDistribution) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+type PoissonDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lambda        float64                `protobuf:"fixed64,1,opt,name=Lambda,proto3" json:"Lambda,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PoissonDistribution) Reset() {
+	*x = PoissonDistribution{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PoissonDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoissonDistribution) ProtoMessage() {}
+
+func (x *PoissonDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoissonDistribution.ProtoReflect.Descriptor instead.
+func (*PoissonDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PoissonDistribution) GetLambda() float64 {
+	if x != nil {
+		return x.Lambda
+	}
+	return 0
+}
+
+type BinomialDistribution struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	N             int64                  `protobuf:"varint,1,opt,name=N,proto3" json:"N,omitempty"`
+	P             float64                `protobuf:"fixed64,2,opt,name=P,proto3" json:"P,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BinomialDistribution) Reset() {
+	*x = BinomialDistribution{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinomialDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinomialDistribution) ProtoMessage() {}
+
+func (x *BinomialDistribution) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinomialDistribution.ProtoReflect.Descriptor instead.
+func (*BinomialDistribution) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BinomialDistribution) GetN() int64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+func (x *BinomialDistribution) GetP() float64 {
+	if x != nil {
+		return x.P
+	}
+	return 0
+}
+
+type GetRandBytesRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum  int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Mode     Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Length   int32                  `protobuf:"varint,3,opt,name=Length,proto3" json:"Length,omitempty"`
+	Encoding Encoding               `protobuf:"varint,4,opt,name=Encoding,proto3,enum=random.Encoding" json:"Encoding,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,5,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandBytesRequest) Reset() {
+	*x = GetRandBytesRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandBytesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandBytesRequest) ProtoMessage() {}
+
+func (x *GetRandBytesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandBytesRequest.ProtoReflect.Descriptor instead.
+func (*GetRandBytesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetRandBytesRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetRandBytesRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetRandBytesRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GetRandBytesRequest) GetEncoding() Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *GetRandBytesRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type GetRandBytesReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Data is Length random bytes in the requested encoding.
+	Data             string   `protobuf:"bytes,1,opt,name=Data,proto3" json:"Data,omitempty"`
+	Encoding         Encoding `protobuf:"varint,2,opt,name=Encoding,proto3,enum=random.Encoding" json:"Encoding,omitempty"`
+	Mode             Mode     `protobuf:"varint,3,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Algorithm        string   `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string   `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandBytesReply) Reset() {
+	*x = GetRandBytesReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandBytesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandBytesReply) ProtoMessage() {}
+
+func (x *GetRandBytesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandBytesReply.ProtoReflect.Descriptor instead.
+func (*GetRandBytesReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetRandBytesReply) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+func (x *GetRandBytesReply) GetEncoding() Encoding {
+	if x != nil {
+		return x.Encoding
+	}
+	return Encoding_ENCODING_UNSPECIFIED
+}
+
+func (x *GetRandBytesReply) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetRandBytesReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetRandBytesReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetRandBytesReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetUUIDsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Mode    Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Version UUIDVersion            `protobuf:"varint,3,opt,name=Version,proto3,enum=random.UUIDVersion" json:"Version,omitempty"`
+	Count   int32                  `protobuf:"varint,4,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,5,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUUIDsRequest) Reset() {
+	*x = GetUUIDsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUUIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUUIDsRequest) ProtoMessage() {}
+
+func (x *GetUUIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUUIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetUUIDsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetUUIDsRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetUUIDsRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetUUIDsRequest) GetVersion() UUIDVersion {
+	if x != nil {
+		return x.Version
+	}
+	return UUIDVersion_UUID_VERSION_UNSPECIFIED
+}
+
+func (x *GetUUIDsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetUUIDsRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type GetUUIDsReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UUIDs            []string               `protobuf:"bytes,1,rep,name=UUIDs,proto3" json:"UUIDs,omitempty"`
+	Version          UUIDVersion            `protobuf:"varint,2,opt,name=Version,proto3,enum=random.UUIDVersion" json:"Version,omitempty"`
+	Mode             Mode                   `protobuf:"varint,3,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string                 `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUUIDsReply) Reset() {
+	*x = GetUUIDsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUUIDsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUUIDsReply) ProtoMessage() {}
+
+func (x *GetUUIDsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUUIDsReply.ProtoReflect.Descriptor instead.
+func (*GetUUIDsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetUUIDsReply) GetUUIDs() []string {
+	if x != nil {
+		return x.UUIDs
+	}
+	return nil
+}
+
+func (x *GetUUIDsReply) GetVersion() UUIDVersion {
+	if x != nil {
+		return x.Version
+	}
+	return UUIDVersion_UUID_VERSION_UNSPECIFIED
+}
+
+func (x *GetUUIDsReply) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetUUIDsReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetUUIDsReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetUUIDsReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetTokenRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Mode    Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	// Length is the number of characters of the token.
+	Length int32 `protobuf:"varint,3,opt,name=Length,proto3" json:"Length,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenRequest) Reset() {
+	*x = GetTokenRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenRequest) ProtoMessage() {}
+
+func (x *GetTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetTokenRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetTokenRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetTokenRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GetTokenRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type GetTokenReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token only uses the URL-safe base64 alphabet (A-Z, a-z, 0-9, '-' and '_').
+	Token            string `protobuf:"bytes,1,opt,name=Token,proto3" json:"Token,omitempty"`
+	Mode             Mode   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Algorithm        string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTokenReply) Reset() {
+	*x = GetTokenReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTokenReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenReply) ProtoMessage() {}
+
+func (x *GetTokenReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenReply.ProtoReflect.Descriptor instead.
+func (*GetTokenReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetTokenReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *GetTokenReply) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetTokenReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetTokenReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetTokenReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetRandStringsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Mode    Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	// Types that are valid to be assigned to Spec:
+	//
+	//	*GetRandStringsRequest_Pattern
+	//	*GetRandStringsRequest_Charset
+	Spec isGetRandStringsRequest_Spec `protobuf_oneof:"Spec"`
+	// Length is the number of characters of the strings built from Charset.
+	Length int32 `protobuf:"varint,5,opt,name=Length,proto3" json:"Length,omitempty"`
+	// Count is the number of strings to generate, 0 returns one string.
+	Count int32 `protobuf:"varint,6,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,7,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandStringsRequest) Reset() {
+	*x = GetRandStringsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandStringsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandStringsRequest) ProtoMessage() {}
+
+func (x *GetRandStringsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandStringsRequest.ProtoReflect.Descriptor instead.
+func (*GetRandStringsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetRandStringsRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetRandStringsRequest) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetRandStringsRequest) GetSpec() isGetRandStringsRequest_Spec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *GetRandStringsRequest) GetPattern() string {
+	if x != nil {
+		if x, ok := x.Spec.(*GetRandStringsRequest_Pattern); ok {
+			return x.Pattern
+		}
+	}
+	return ""
+}
+
+func (x *GetRandStringsRequest) GetCharset() string {
+	if x != nil {
+		if x, ok := x.Spec.(*GetRandStringsRequest_Charset); ok {
+			return x.Charset
+		}
+	}
+	return ""
+}
+
+func (x *GetRandStringsRequest) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+func (x *GetRandStringsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRandStringsRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type isGetRandStringsRequest_Spec interface {
+	isGetRandStringsRequest_Spec()
+}
+
+type GetRandStringsRequest_Pattern struct {
+	// Pattern is a restricted regular expression of printable ASCII, e.g.
+	// "[A-Z]{3}-[0-9]{4}". It supports literals, character classes with
+	// ranges, \d, \w, escapes with \, and the quantifiers {n}, {n,m} and ?.
+	Pattern string `protobuf:"bytes,3,opt,name=Pattern,proto3,oneof"`
+}
+
+type GetRandStringsRequest_Charset struct {
+	// Charset is the inside of a character class, e.g. "A-Z0-9".
+	Charset string `protobuf:"bytes,4,opt,name=Charset,proto3,oneof"`
+}
+
+func (*GetRandStringsRequest_Pattern) isGetRandStringsRequest_Spec() {}
+
+func (*GetRandStringsRequest_Charset) isGetRandStringsRequest_Spec() {}
+
+type GetRandStringsReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Strings          []string               `protobuf:"bytes,1,rep,name=Strings,proto3" json:"Strings,omitempty"`
+	Mode             Mode                   `protobuf:"varint,2,opt,name=Mode,proto3,enum=random.Mode" json:"Mode,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string                 `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandStringsReply) Reset() {
+	*x = GetRandStringsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandStringsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandStringsReply) ProtoMessage() {}
+
+func (x *GetRandStringsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandStringsReply.ProtoReflect.Descriptor instead.
+func (*GetRandStringsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetRandStringsReply) GetStrings() []string {
+	if x != nil {
+		return x.Strings
+	}
+	return nil
+}
+
+func (x *GetRandStringsReply) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_UNSPECIFIED
+}
+
+func (x *GetRandStringsReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetRandStringsReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetRandStringsReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GenerateRecordsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Fields  []*FieldSpec           `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	Count   int64                  `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRecordsRequest) Reset() {
+	*x = GenerateRecordsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecordsRequest) ProtoMessage() {}
+
+func (x *GenerateRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecordsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GenerateRecordsRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GenerateRecordsRequest) GetFields() []*FieldSpec {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GenerateRecordsRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GenerateRecordsRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type FieldSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*FieldSpec_PersonName
+	//	*FieldSpec_Email
+	//	*FieldSpec_IntRange
+	//	*FieldSpec_DateRange
+	//	*FieldSpec_Enum
+	//	*FieldSpec_UUID
+	Type          isFieldSpec_Type `protobuf_oneof:"Type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldSpec) Reset() {
+	*x = FieldSpec{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldSpec) ProtoMessage() {}
+
+func (x *FieldSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldSpec.ProtoReflect.Descriptor instead.
+func (*FieldSpec) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *FieldSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldSpec) GetType() isFieldSpec_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetPersonName() *PersonNameType {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_PersonName); ok {
+			return x.PersonName
+		}
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetEmail() *EmailType {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_Email); ok {
+			return x.Email
+		}
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetIntRange() *IntRange {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_IntRange); ok {
+			return x.IntRange
+		}
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetDateRange() *DateRange {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_DateRange); ok {
+			return x.DateRange
+		}
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetEnum() *EnumType {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_Enum); ok {
+			return x.Enum
+		}
+	}
+	return nil
+}
+
+func (x *FieldSpec) GetUUID() *UUIDType {
+	if x != nil {
+		if x, ok := x.Type.(*FieldSpec_UUID); ok {
+			return x.UUID
+		}
+	}
+	return nil
+}
+
+type isFieldSpec_Type interface {
+	isFieldSpec_Type()
+}
+
+type FieldSpec_PersonName struct {
+	PersonName *PersonNameType `protobuf:"bytes,2,opt,name=PersonName,proto3,oneof"`
+}
+
+type FieldSpec_Email struct {
+	Email *EmailType `protobuf:"bytes,3,opt,name=Email,proto3,oneof"`
+}
+
+type FieldSpec_IntRange struct {
+	IntRange *IntRange `protobuf:"bytes,4,opt,name=IntRange,proto3,oneof"`
+}
+
+type FieldSpec_DateRange struct {
+	DateRange *DateRange `protobuf:"bytes,5,opt,name=DateRange,proto3,oneof"`
+}
+
+type FieldSpec_Enum struct {
+	Enum *EnumType `protobuf:"bytes,6,opt,name=Enum,proto3,oneof"`
+}
+
+type FieldSpec_UUID struct {
+	UUID *UUIDType `protobuf:"bytes,7,opt,name=UUID,proto3,oneof"`
+}
+
+func (*FieldSpec_PersonName) isFieldSpec_Type() {}
+
+func (*FieldSpec_Email) isFieldSpec_Type() {}
+
+func (*FieldSpec_IntRange) isFieldSpec_Type() {}
+
+func (*FieldSpec_DateRange) isFieldSpec_Type() {}
+
+func (*FieldSpec_Enum) isFieldSpec_Type() {}
+
+func (*FieldSpec_UUID) isFieldSpec_Type() {}
+
+// PersonNameType generates a first and a last name from embedded word lists.
+type PersonNameType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonNameType) Reset() {
+	*x = PersonNameType{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonNameType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonNameType) ProtoMessage() {}
+
+func (x *PersonNameType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonNameType.ProtoReflect.Descriptor instead.
+func (*PersonNameType) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{35}
+}
+
+// EmailType generates addresses such as "ana.silva42@example.com".
+type EmailType struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Domain is the domain of the addresses, empty picks one of the domains
+	// reserved for documentation.
+	Domain        string `protobuf:"bytes,1,opt,name=Domain,proto3" json:"Domain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmailType) Reset() {
+	*x = EmailType{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmailType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmailType) ProtoMessage() {}
+
+func (x *EmailType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmailType.ProtoReflect.Descriptor instead.
+func (*EmailType) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EmailType) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+// DateRange generates days between Min and Max, both inclusive and written
+// as YYYY-MM-DD.
+type DateRange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           string                 `protobuf:"bytes,1,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max           string                 `protobuf:"bytes,2,opt,name=Max,proto3" json:"Max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DateRange) Reset() {
+	*x = DateRange{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DateRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DateRange) ProtoMessage() {}
+
+func (x *DateRange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DateRange.ProtoReflect.Descriptor instead.
+func (*DateRange) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DateRange) GetMin() string {
+	if x != nil {
+		return x.Min
+	}
+	return ""
+}
+
+func (x *DateRange) GetMax() string {
+	if x != nil {
+		return x.Max
+	}
+	return ""
+}
+
+type EnumType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=Values,proto3" json:"Values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnumType) Reset() {
+	*x = EnumType{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnumType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumType) ProtoMessage() {}
+
+func (x *EnumType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumType.ProtoReflect.Descriptor instead.
+func (*EnumType) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *EnumType) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// UUIDType generates version 4 UUIDs.
+type UUIDType struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UUIDType) Reset() {
+	*x = UUIDType{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UUIDType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UUIDType) ProtoMessage() {}
+
+func (x *UUIDType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UUIDType.ProtoReflect.Descriptor instead.
+func (*UUIDType) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{39}
+}
+
+type GenerateRecordsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int64                  `protobuf:"varint,1,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Fields are in the order of the schema.
+	Fields []*FieldValue `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateRecordsReply) Reset() {
+	*x = GenerateRecordsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateRecordsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateRecordsReply) ProtoMessage() {}
+
+func (x *GenerateRecordsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateRecordsReply.ProtoReflect.Descriptor instead.
+func (*GenerateRecordsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GenerateRecordsReply) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GenerateRecordsReply) GetFields() []*FieldValue {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *GenerateRecordsReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type FieldValue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Types that are valid to be assigned to Value:
+	//
+	//	*FieldValue_String_
+	//	*FieldValue_Int
+	Value         isFieldValue_Value `protobuf_oneof:"Value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldValue) Reset() {
+	*x = FieldValue{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldValue) ProtoMessage() {}
+
+func (x *FieldValue) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldValue.ProtoReflect.Descriptor instead.
+func (*FieldValue) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *FieldValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldValue) GetValue() isFieldValue_Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *FieldValue) GetString_() string {
+	if x != nil {
+		if x, ok := x.Value.(*FieldValue_String_); ok {
+			return x.String_
+		}
+	}
+	return ""
+}
+
+func (x *FieldValue) GetInt() int64 {
+	if x != nil {
+		if x, ok := x.Value.(*FieldValue_Int); ok {
+			return x.Int
+		}
+	}
+	return 0
+}
+
+type isFieldValue_Value interface {
+	isFieldValue_Value()
+}
+
+type FieldValue_String_ struct {
+	String_ string `protobuf:"bytes,2,opt,name=String,proto3,oneof"`
+}
+
+type FieldValue_Int struct {
+	Int int64 `protobuf:"varint,3,opt,name=Int,proto3,oneof"`
+}
+
+func (*FieldValue_String_) isFieldValue_Value() {}
+
+func (*FieldValue_Int) isFieldValue_Value() {}
+
+type ShuffleRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Types that are valid to be assigned to Population:
+	//
+	//	*ShuffleRequest_Items
+	//	*ShuffleRequest_Size
+	Population isShuffleRequest_Population `protobuf_oneof:"Population"`
+	// SampleSize is the number of items to sample without replacement, 0 returns
+	// the whole permutation. A sample of k items is the first k items of the
+	// permutation for the same seed.
+	SampleSize int64 `protobuf:"varint,4,opt,name=SampleSize,proto3" json:"SampleSize,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,5,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleRequest) Reset() {
+	*x = ShuffleRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleRequest) ProtoMessage() {}
+
+func (x *ShuffleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleRequest.ProtoReflect.Descriptor instead.
+func (*ShuffleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ShuffleRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *ShuffleRequest) GetPopulation() isShuffleRequest_Population {
+	if x != nil {
+		return x.Population
+	}
+	return nil
+}
+
+func (x *ShuffleRequest) GetItems() *ShuffleItems {
+	if x != nil {
+		if x, ok := x.Population.(*ShuffleRequest_Items); ok {
+			return x.Items
+		}
+	}
+	return nil
+}
+
+func (x *ShuffleRequest) GetSize() int64 {
+	if x != nil {
+		if x, ok := x.Population.(*ShuffleRequest_Size); ok {
+			return x.Size
+		}
+	}
+	return 0
+}
+
+func (x *ShuffleRequest) GetSampleSize() int64 {
+	if x != nil {
+		return x.SampleSize
+	}
+	return 0
+}
+
+func (x *ShuffleRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type isShuffleRequest_Population interface {
+	isShuffleRequest_Population()
+}
+
+type ShuffleRequest_Items struct {
+	// Items are shuffled and returned in the reply.
+	Items *ShuffleItems `protobuf:"bytes,2,opt,name=Items,proto3,oneof"`
+}
+
+type ShuffleRequest_Size struct {
+	// Size shuffles the positions [0, Size), only Indices are returned.
+	Size int64 `protobuf:"varint,3,opt,name=Size,proto3,oneof"`
+}
+
+func (*ShuffleRequest_Items) isShuffleRequest_Population() {}
+
+func (*ShuffleRequest_Size) isShuffleRequest_Population() {}
+
+type ShuffleItems struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Values        []string               `protobuf:"bytes,1,rep,name=Values,proto3" json:"Values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleItems) Reset() {
+	*x = ShuffleItems{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleItems) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleItems) ProtoMessage() {}
+
+func (x *ShuffleItems) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleItems.ProtoReflect.Descriptor instead.
+func (*ShuffleItems) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ShuffleItems) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ShuffleReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Items are the shuffled or sampled items, only set when Items were requested.
+	Items []string `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
+	// Indices are the positions of the selected items in the population.
+	Indices []int64 `protobuf:"varint,2,rep,packed,name=Indices,proto3" json:"Indices,omitempty"`
+	// ShuffleAlgorithm identifies the shuffling algorithm, the same seed,
+	// generator and shuffling algorithm always produce the same result.
+	ShuffleAlgorithm string `protobuf:"bytes,3,opt,name=ShuffleAlgorithm,proto3" json:"ShuffleAlgorithm,omitempty"`
+	// Algorithm and AlgorithmVersion identify the generator driving the shuffle.
+	Algorithm        string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShuffleReply) Reset() {
+	*x = ShuffleReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShuffleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShuffleReply) ProtoMessage() {}
+
+func (x *ShuffleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShuffleReply.ProtoReflect.Descriptor instead.
+func (*ShuffleReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ShuffleReply) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ShuffleReply) GetIndices() []int64 {
+	if x != nil {
+		return x.Indices
+	}
+	return nil
+}
+
+func (x *ShuffleReply) GetShuffleAlgorithm() string {
+	if x != nil {
+		return x.ShuffleAlgorithm
+	}
+	return ""
+}
+
+func (x *ShuffleReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *ShuffleReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *ShuffleReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetRandNumberAtRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Index is the position of the first value in the sequence of SeedNum,
+	// starting at 0. The server jumps to it without generating the values before.
+	Index int64 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Count is the number of successive values to return, 0 returns one value.
+	Count int64 `protobuf:"varint,3,opt,name=Count,proto3" json:"Count,omitempty"`
+	// Algorithm is the seeded generator, it must be able to jump ahead.
+	// Empty selects "pcg", the only one that currently can.
+	Algorithm     string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumberAtRequest) Reset() {
+	*x = GetRandNumberAtRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumberAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumberAtRequest) ProtoMessage() {}
+
+func (x *GetRandNumberAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumberAtRequest.ProtoReflect.Descriptor instead.
+func (*GetRandNumberAtRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetRandNumberAtRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *GetRandNumberAtRequest) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetRandNumberAtRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetRandNumberAtRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type GetRandNumberAtReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Numbers are the values at positions [Index, Index + Count).
+	Numbers []int64 `protobuf:"varint,1,rep,packed,name=Numbers,proto3" json:"Numbers,omitempty"`
+	Index   int64   `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Algorithm is the generator of the sequence.
+	Algorithm        string `protobuf:"bytes,3,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,4,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,5,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRandNumberAtReply) Reset() {
+	*x = GetRandNumberAtReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRandNumberAtReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRandNumberAtReply) ProtoMessage() {}
+
+func (x *GetRandNumberAtReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRandNumberAtReply.ProtoReflect.Descriptor instead.
+func (*GetRandNumberAtReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetRandNumberAtReply) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *GetRandNumberAtReply) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetRandNumberAtReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *GetRandNumberAtReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *GetRandNumberAtReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CreateGeneratorRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGeneratorRequest) Reset() {
+	*x = CreateGeneratorRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGeneratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGeneratorRequest) ProtoMessage() {}
+
+func (x *CreateGeneratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGeneratorRequest.ProtoReflect.Descriptor instead.
+func (*CreateGeneratorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateGeneratorRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *CreateGeneratorRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type CreateGeneratorReply struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	GeneratorID      string                 `protobuf:"bytes,1,opt,name=GeneratorID,proto3" json:"GeneratorID,omitempty"`
+	Algorithm        string                 `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string                 `protobuf:"bytes,3,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// IdleTimeoutSeconds is how long the generator lives without calls to Next.
+	IdleTimeoutSeconds int64 `protobuf:"varint,4,opt,name=IdleTimeoutSeconds,proto3" json:"IdleTimeoutSeconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateGeneratorReply) Reset() {
+	*x = CreateGeneratorReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGeneratorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGeneratorReply) ProtoMessage() {}
+
+func (x *CreateGeneratorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGeneratorReply.ProtoReflect.Descriptor instead.
+func (*CreateGeneratorReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateGeneratorReply) GetGeneratorID() string {
+	if x != nil {
+		return x.GeneratorID
+	}
+	return ""
+}
+
+func (x *CreateGeneratorReply) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *CreateGeneratorReply) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *CreateGeneratorReply) GetIdleTimeoutSeconds() int64 {
+	if x != nil {
+		return x.IdleTimeoutSeconds
+	}
+	return 0
+}
+
+type NextRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	GeneratorID string                 `protobuf:"bytes,1,opt,name=GeneratorID,proto3" json:"GeneratorID,omitempty"`
+	// Count is the number of values to generate, 0 returns one value.
+	Count         int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextRequest) Reset() {
+	*x = NextRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextRequest) ProtoMessage() {}
+
+func (x *NextRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextRequest.ProtoReflect.Descriptor instead.
+func (*NextRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *NextRequest) GetGeneratorID() string {
+	if x != nil {
+		return x.GeneratorID
+	}
+	return ""
+}
+
+func (x *NextRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NextReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Numbers []int64                `protobuf:"varint,1,rep,packed,name=Numbers,proto3" json:"Numbers,omitempty"`
+	// Index is the position of Numbers[0] in the sequence of the generator.
+	Index int64 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,3,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextReply) Reset() {
+	*x = NextReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextReply) ProtoMessage() {}
+
+func (x *NextReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextReply.ProtoReflect.Descriptor instead.
+func (*NextReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *NextReply) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *NextReply) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NextReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CloseGeneratorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GeneratorID   string                 `protobuf:"bytes,1,opt,name=GeneratorID,proto3" json:"GeneratorID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseGeneratorRequest) Reset() {
+	*x = CloseGeneratorRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseGeneratorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseGeneratorRequest) ProtoMessage() {}
+
+func (x *CloseGeneratorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseGeneratorRequest.ProtoReflect.Descriptor instead.
+func (*CloseGeneratorRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CloseGeneratorRequest) GetGeneratorID() string {
+	if x != nil {
+		return x.GeneratorID
+	}
+	return ""
+}
+
+type CloseGeneratorReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseGeneratorReply) Reset() {
+	*x = CloseGeneratorReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseGeneratorReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseGeneratorReply) ProtoMessage() {}
+
+func (x *CloseGeneratorReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseGeneratorReply.ProtoReflect.Descriptor instead.
+func (*CloseGeneratorReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{52}
+}
+
+type DrawCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID is echoed in the result of the command.
+	ID uint64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Types that are valid to be assigned to Command:
+	//
+	//	*DrawCommand_Reseed
+	//	*DrawCommand_NextInt
+	//	*DrawCommand_NextInRange
+	//	*DrawCommand_Skip
+	Command       isDrawCommand_Command `protobuf_oneof:"Command"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawCommand) Reset() {
+	*x = DrawCommand{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawCommand) ProtoMessage() {}
+
+func (x *DrawCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawCommand.ProtoReflect.Descriptor instead.
+func (*DrawCommand) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DrawCommand) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DrawCommand) GetCommand() isDrawCommand_Command {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *DrawCommand) GetReseed() *ReseedCommand {
+	if x != nil {
+		if x, ok := x.Command.(*DrawCommand_Reseed); ok {
+			return x.Reseed
+		}
+	}
+	return nil
+}
+
+func (x *DrawCommand) GetNextInt() *NextIntCommand {
+	if x != nil {
+		if x, ok := x.Command.(*DrawCommand_NextInt); ok {
+			return x.NextInt
+		}
+	}
+	return nil
+}
+
+func (x *DrawCommand) GetNextInRange() *NextInRangeCommand {
+	if x != nil {
+		if x, ok := x.Command.(*DrawCommand_NextInRange); ok {
+			return x.NextInRange
+		}
+	}
+	return nil
+}
+
+func (x *DrawCommand) GetSkip() *SkipCommand {
+	if x != nil {
+		if x, ok := x.Command.(*DrawCommand_Skip); ok {
+			return x.Skip
+		}
+	}
+	return nil
+}
+
+type isDrawCommand_Command interface {
+	isDrawCommand_Command()
+}
+
+type DrawCommand_Reseed struct {
+	Reseed *ReseedCommand `protobuf:"bytes,2,opt,name=Reseed,proto3,oneof"`
+}
+
+type DrawCommand_NextInt struct {
+	NextInt *NextIntCommand `protobuf:"bytes,3,opt,name=NextInt,proto3,oneof"`
+}
+
+type DrawCommand_NextInRange struct {
+	NextInRange *NextInRangeCommand `protobuf:"bytes,4,opt,name=NextInRange,proto3,oneof"`
+}
+
+type DrawCommand_Skip struct {
+	Skip *SkipCommand `protobuf:"bytes,5,opt,name=Skip,proto3,oneof"`
+}
+
+func (*DrawCommand_Reseed) isDrawCommand_Command() {}
+
+func (*DrawCommand_NextInt) isDrawCommand_Command() {}
+
+func (*DrawCommand_NextInRange) isDrawCommand_Command() {}
+
+func (*DrawCommand_Skip) isDrawCommand_Command() {}
+
+type ReseedCommand struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm     string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReseedCommand) Reset() {
+	*x = ReseedCommand{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReseedCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReseedCommand) ProtoMessage() {}
+
+func (x *ReseedCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReseedCommand.ProtoReflect.Descriptor instead.
+func (*ReseedCommand) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ReseedCommand) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *ReseedCommand) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+type NextIntCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Count is the number of values to draw, 0 draws one value.
+	Count         int64 `protobuf:"varint,1,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextIntCommand) Reset() {
+	*x = NextIntCommand{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextIntCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextIntCommand) ProtoMessage() {}
+
+func (x *NextIntCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextIntCommand.ProtoReflect.Descriptor instead.
+func (*NextIntCommand) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *NextIntCommand) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type NextInRangeCommand struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Range *IntRange              `protobuf:"bytes,1,opt,name=Range,proto3" json:"Range,omitempty"`
+	// Count is the number of values to draw, 0 draws one value.
+	Count         int64 `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextInRangeCommand) Reset() {
+	*x = NextInRangeCommand{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextInRangeCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextInRangeCommand) ProtoMessage() {}
+
+func (x *NextInRangeCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextInRangeCommand.ProtoReflect.Descriptor instead.
+func (*NextInRangeCommand) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *NextInRangeCommand) GetRange() *IntRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *NextInRangeCommand) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SkipCommand struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	N             int64                  `protobuf:"varint,1,opt,name=N,proto3" json:"N,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SkipCommand) Reset() {
+	*x = SkipCommand{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SkipCommand) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SkipCommand) ProtoMessage() {}
+
+func (x *SkipCommand) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SkipCommand.ProtoReflect.Descriptor instead.
+func (*SkipCommand) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SkipCommand) GetN() int64 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type DrawResult struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	ID      uint64                 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Numbers []int64                `protobuf:"varint,2,rep,packed,name=Numbers,proto3" json:"Numbers,omitempty"`
+	// Index is the position of Numbers[0] among the values drawn or skipped
+	// since the last reseed, or the position after a reseed or a skip.
+	Index int64 `protobuf:"varint,3,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Algorithm and AlgorithmVersion are set in the result of a reseed.
+	Algorithm        string `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawResult) Reset() {
+	*x = DrawResult{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawResult) ProtoMessage() {}
+
+func (x *DrawResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawResult.ProtoReflect.Descriptor instead.
+func (*DrawResult) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DrawResult) GetID() uint64 {
+	if x != nil {
+		return x.ID
+	}
+	return 0
+}
+
+func (x *DrawResult) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *DrawResult) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *DrawResult) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DrawResult) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *DrawResult) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CreateCommitmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommitmentRequest) Reset() {
+	*x = CreateCommitmentRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommitmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommitmentRequest) ProtoMessage() {}
+
+func (x *CreateCommitmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommitmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateCommitmentRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{59}
+}
+
+type CreateCommitmentReply struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CommitmentID string                 `protobuf:"bytes,1,opt,name=CommitmentID,proto3" json:"CommitmentID,omitempty"`
+	// Commitment is the hex SHA-256 of the server secret.
+	Commitment string `protobuf:"bytes,2,opt,name=Commitment,proto3" json:"Commitment,omitempty"`
+	// ExpiresAt is the Unix time in seconds after which Draw rejects the commitment.
+	ExpiresAt     int64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCommitmentReply) Reset() {
+	*x = CreateCommitmentReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCommitmentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCommitmentReply) ProtoMessage() {}
+
+func (x *CreateCommitmentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCommitmentReply.ProtoReflect.Descriptor instead.
+func (*CreateCommitmentReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateCommitmentReply) GetCommitmentID() string {
+	if x != nil {
+		return x.CommitmentID
+	}
+	return ""
+}
+
+func (x *CreateCommitmentReply) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *CreateCommitmentReply) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type DrawRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	CommitmentID string                 `protobuf:"bytes,1,opt,name=CommitmentID,proto3" json:"CommitmentID,omitempty"`
+	ClientSeed   string                 `protobuf:"bytes,2,opt,name=ClientSeed,proto3" json:"ClientSeed,omitempty"`
+	// Range bounds the number, it covers every non-negative int64 when unset.
+	Range         *IntRange `protobuf:"bytes,3,opt,name=Range,proto3" json:"Range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawRequest) Reset() {
+	*x = DrawRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRequest) ProtoMessage() {}
+
+func (x *DrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRequest.ProtoReflect.Descriptor instead.
+func (*DrawRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DrawRequest) GetCommitmentID() string {
+	if x != nil {
+		return x.CommitmentID
+	}
+	return ""
+}
+
+func (x *DrawRequest) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *DrawRequest) GetRange() *IntRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type DrawReply struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Number     int64                  `protobuf:"varint,1,opt,name=Number,proto3" json:"Number,omitempty"`
+	Commitment string                 `protobuf:"bytes,2,opt,name=Commitment,proto3" json:"Commitment,omitempty"`
+	// Secret is the hex encoded server secret, its SHA-256 is Commitment.
+	Secret     string    `protobuf:"bytes,3,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	ClientSeed string    `protobuf:"bytes,4,opt,name=ClientSeed,proto3" json:"ClientSeed,omitempty"`
+	Range      *IntRange `protobuf:"bytes,5,opt,name=Range,proto3" json:"Range,omitempty"`
+	// Signature is set when the server signs its outputs.
+	Signature     *Signature `protobuf:"bytes,6,opt,name=Signature,proto3" json:"Signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawReply) Reset() {
+	*x = DrawReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawReply) ProtoMessage() {}
+
+func (x *DrawReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawReply.ProtoReflect.Descriptor instead.
+func (*DrawReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *DrawReply) GetNumber() int64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *DrawReply) GetCommitment() string {
+	if x != nil {
+		return x.Commitment
+	}
+	return ""
+}
+
+func (x *DrawReply) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *DrawReply) GetClientSeed() string {
+	if x != nil {
+		return x.ClientSeed
+	}
+	return ""
+}
+
+func (x *DrawReply) GetRange() *IntRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+func (x *DrawReply) GetSignature() *Signature {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type GetPublicKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// KeyID selects a key, empty selects the key signing new outputs.
+	KeyID         string `protobuf:"bytes,1,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPublicKeyRequest) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+type GetPublicKeyReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	KeyID string                 `protobuf:"bytes,1,opt,name=KeyID,proto3" json:"KeyID,omitempty"`
+	// PublicKey is the raw 32 byte Ed25519 public key.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=PublicKey,proto3" json:"PublicKey,omitempty"`
+	// Active is false for retired keys, which only verify older outputs.
+	Active        bool `protobuf:"varint,3,opt,name=Active,proto3" json:"Active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicKeyReply) Reset() {
+	*x = GetPublicKeyReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicKeyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyReply) ProtoMessage() {}
+
+func (x *GetPublicKeyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyReply.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetPublicKeyReply) GetKeyID() string {
+	if x != nil {
+		return x.KeyID
+	}
+	return ""
+}
+
+func (x *GetPublicKeyReply) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *GetPublicKeyReply) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type SubmitJobRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	SeedNum int64                  `protobuf:"varint,1,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	// Algorithm is the seeded generator, empty selects the server's default.
+	Algorithm string `protobuf:"bytes,2,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	// Samples is the number of random points drawn, the server caps it.
+	Samples int64 `protobuf:"varint,3,opt,name=Samples,proto3" json:"Samples,omitempty"`
+	// Types that are valid to be assigned to Estimate:
+	//
+	//	*SubmitJobRequest_Pi
+	//	*SubmitJobRequest_Integral
+	Estimate      isSubmitJobRequest_Estimate `protobuf_oneof:"Estimate"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobRequest) Reset() {
+	*x = SubmitJobRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobRequest) ProtoMessage() {}
+
+func (x *SubmitJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobRequest.ProtoReflect.Descriptor instead.
+func (*SubmitJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SubmitJobRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *SubmitJobRequest) GetSamples() int64 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *SubmitJobRequest) GetEstimate() isSubmitJobRequest_Estimate {
+	if x != nil {
+		return x.Estimate
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetPi() *PiEstimate {
+	if x != nil {
+		if x, ok := x.Estimate.(*SubmitJobRequest_Pi); ok {
+			return x.Pi
+		}
+	}
+	return nil
+}
+
+func (x *SubmitJobRequest) GetIntegral() *IntegralEstimate {
+	if x != nil {
+		if x, ok := x.Estimate.(*SubmitJobRequest_Integral); ok {
+			return x.Integral
+		}
+	}
+	return nil
+}
+
+type isSubmitJobRequest_Estimate interface {
+	isSubmitJobRequest_Estimate()
+}
+
+type SubmitJobRequest_Pi struct {
+	Pi *PiEstimate `protobuf:"bytes,4,opt,name=Pi,proto3,oneof"`
+}
+
+type SubmitJobRequest_Integral struct {
+	Integral *IntegralEstimate `protobuf:"bytes,5,opt,name=Integral,proto3,oneof"`
+}
+
+func (*SubmitJobRequest_Pi) isSubmitJobRequest_Estimate() {}
+
+func (*SubmitJobRequest_Integral) isSubmitJobRequest_Estimate() {}
+
+// PiEstimate estimates pi from the share of random points of the unit
+// square that fall within the quarter disc.
+type PiEstimate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PiEstimate) Reset() {
+	*x = PiEstimate{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PiEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PiEstimate) ProtoMessage() {}
+
+func (x *PiEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PiEstimate.ProtoReflect.Descriptor instead.
+func (*PiEstimate) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{66}
+}
+
+// IntegralEstimate estimates the integral of Expression over [Min, Max].
+type IntegralEstimate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Expression is a function of x made of numbers, x, pi, e, + - * / ^,
+	// parentheses and the functions abs, cos, exp, log, sin, sqrt and tan,
+	// e.g. "4 / (1 + x^2)".
+	Expression    string  `protobuf:"bytes,1,opt,name=Expression,proto3" json:"Expression,omitempty"`
+	Min           float64 `protobuf:"fixed64,2,opt,name=Min,proto3" json:"Min,omitempty"`
+	Max           float64 `protobuf:"fixed64,3,opt,name=Max,proto3" json:"Max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegralEstimate) Reset() {
+	*x = IntegralEstimate{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegralEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegralEstimate) ProtoMessage() {}
+
+func (x *IntegralEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegralEstimate.ProtoReflect.Descriptor instead.
+func (*IntegralEstimate) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *IntegralEstimate) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *IntegralEstimate) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *IntegralEstimate) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type Job struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	JobID string                 `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	State JobState               `protobuf:"varint,2,opt,name=State,proto3,enum=random.JobState" json:"State,omitempty"`
+	// Request is the request that submitted the job.
+	Request          *SubmitJobRequest `protobuf:"bytes,3,opt,name=Request,proto3" json:"Request,omitempty"`
+	Algorithm        string            `protobuf:"bytes,4,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string            `protobuf:"bytes,5,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Progress is the number of samples drawn so far.
+	Progress int64 `protobuf:"varint,6,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	// Estimate and StdError, its standard error, are set once the job succeeded.
+	Estimate float64 `protobuf:"fixed64,7,opt,name=Estimate,proto3" json:"Estimate,omitempty"`
+	StdError float64 `protobuf:"fixed64,8,opt,name=StdError,proto3" json:"StdError,omitempty"`
+	// Error explains why the job failed or was cancelled.
+	Error string `protobuf:"bytes,9,opt,name=Error,proto3" json:"Error,omitempty"`
+	// CreatedAt, StartedAt and FinishedAt are Unix times in nanoseconds, 0
+	// until the job reaches that point.
+	CreatedAt     int64 `protobuf:"varint,10,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	StartedAt     int64 `protobuf:"varint,11,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	FinishedAt    int64 `protobuf:"varint,12,opt,name=FinishedAt,proto3" json:"FinishedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Job) Reset() {
+	*x = Job{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Job) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Job) ProtoMessage() {}
+
+func (x *Job) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Job.ProtoReflect.Descriptor instead.
+func (*Job) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *Job) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+func (x *Job) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+func (x *Job) GetRequest() *SubmitJobRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *Job) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *Job) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *Job) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Job) GetEstimate() float64 {
+	if x != nil {
+		return x.Estimate
+	}
+	return 0
+}
+
+func (x *Job) GetStdError() float64 {
+	if x != nil {
+		return x.StdError
+	}
+	return 0
+}
+
+func (x *Job) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Job) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Job) GetStartedAt() int64 {
+	if x != nil {
+		return x.StartedAt
+	}
+	return 0
+}
+
+func (x *Job) GetFinishedAt() int64 {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return 0
+}
+
+type SubmitJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitJobReply) Reset() {
+	*x = SubmitJobReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitJobReply) ProtoMessage() {}
+
+func (x *SubmitJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitJobReply.ProtoReflect.Descriptor instead.
+func (*SubmitJobReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SubmitJobReply) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobID         string                 `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobRequest) Reset() {
+	*x = GetJobRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobRequest) ProtoMessage() {}
+
+func (x *GetJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobRequest.ProtoReflect.Descriptor instead.
+func (*GetJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetJobRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type GetJobReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *Job                   `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJobReply) Reset() {
+	*x = GetJobReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJobReply) ProtoMessage() {}
+
+func (x *GetJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJobReply.ProtoReflect.Descriptor instead.
+func (*GetJobReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetJobReply) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type CancelJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	JobID         string                 `protobuf:"bytes,1,opt,name=JobID,proto3" json:"JobID,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobRequest) Reset() {
+	*x = CancelJobRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobRequest) ProtoMessage() {}
+
+func (x *CancelJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobRequest.ProtoReflect.Descriptor instead.
+func (*CancelJobRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CancelJobRequest) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+type CancelJobReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Job is cancelled, unless it had already finished.
+	Job           *Job `protobuf:"bytes,1,opt,name=Job,proto3" json:"Job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelJobReply) Reset() {
+	*x = CancelJobReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelJobReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelJobReply) ProtoMessage() {}
+
+func (x *CancelJobReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelJobReply.ProtoReflect.Descriptor instead.
+func (*CancelJobReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CancelJobReply) GetJob() *Job {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type ListJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State selects the jobs in a state, unspecified selects every job.
+	State         JobState `protobuf:"varint,1,opt,name=State,proto3,enum=random.JobState" json:"State,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsRequest) Reset() {
+	*x = ListJobsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsRequest) ProtoMessage() {}
+
+func (x *ListJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsRequest.ProtoReflect.Descriptor instead.
+func (*ListJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListJobsRequest) GetState() JobState {
+	if x != nil {
+		return x.State
+	}
+	return JobState_JOB_STATE_UNSPECIFIED
+}
+
+type ListJobsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Jobs are sorted from the newest to the oldest.
+	Jobs          []*Job `protobuf:"bytes,1,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJobsReply) Reset() {
+	*x = ListJobsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJobsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJobsReply) ProtoMessage() {}
+
+func (x *ListJobsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJobsReply.ProtoReflect.Descriptor instead.
+func (*ListJobsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListJobsReply) GetJobs() []*Job {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type ListDrawsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From and To bound the time of draws to [From, To), in Unix nanoseconds,
+	// 0 leaves a bound open.
+	From int64 `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To   int64 `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+	// SeedNum, ClientIP and RequestID select the draws with that value when
+	// set.
+	SeedNum   int64  `protobuf:"varint,3,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	ClientIP  string `protobuf:"bytes,4,opt,name=ClientIP,proto3" json:"ClientIP,omitempty"`
+	RequestID string `protobuf:"bytes,5,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	// PageSize is the number of draws per page, 100 when 0.
+	PageSize      int32  `protobuf:"varint,6,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	PageToken     string `protobuf:"bytes,7,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrawsRequest) Reset() {
+	*x = ListDrawsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrawsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrawsRequest) ProtoMessage() {}
+
+func (x *ListDrawsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrawsRequest.ProtoReflect.Descriptor instead.
+func (*ListDrawsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListDrawsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ListDrawsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ListDrawsRequest) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *ListDrawsRequest) GetClientIP() string {
+	if x != nil {
+		return x.ClientIP
+	}
+	return ""
+}
+
+func (x *ListDrawsRequest) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *ListDrawsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDrawsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DrawRecord struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Sequence uint64                 `protobuf:"varint,1,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	// Timestamp is a Unix time in nanoseconds.
+	Timestamp int64  `protobuf:"varint,2,opt,name=Timestamp,proto3" json:"Timestamp,omitempty"`
+	RequestID string `protobuf:"bytes,3,opt,name=RequestID,proto3" json:"RequestID,omitempty"`
+	ClientIP  string `protobuf:"bytes,4,opt,name=ClientIP,proto3" json:"ClientIP,omitempty"`
+	// Method is the full gRPC method that issued the draw.
+	Method string `protobuf:"bytes,5,opt,name=Method,proto3" json:"Method,omitempty"`
+	// SeedNum is 0 for secure draws and requests without a single seed.
+	SeedNum          int64  `protobuf:"varint,6,opt,name=SeedNum,proto3" json:"SeedNum,omitempty"`
+	Algorithm        string `protobuf:"bytes,7,opt,name=Algorithm,proto3" json:"Algorithm,omitempty"`
+	AlgorithmVersion string `protobuf:"bytes,8,opt,name=AlgorithmVersion,proto3" json:"AlgorithmVersion,omitempty"`
+	// Output is the reply, or the stream message, as JSON.
+	Output        string `protobuf:"bytes,9,opt,name=Output,proto3" json:"Output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DrawRecord) Reset() {
+	*x = DrawRecord{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DrawRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrawRecord) ProtoMessage() {}
+
+func (x *DrawRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrawRecord.ProtoReflect.Descriptor instead.
+func (*DrawRecord) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DrawRecord) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *DrawRecord) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DrawRecord) GetRequestID() string {
+	if x != nil {
+		return x.RequestID
+	}
+	return ""
+}
+
+func (x *DrawRecord) GetClientIP() string {
+	if x != nil {
+		return x.ClientIP
+	}
+	return ""
+}
+
+func (x *DrawRecord) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DrawRecord) GetSeedNum() int64 {
+	if x != nil {
+		return x.SeedNum
+	}
+	return 0
+}
+
+func (x *DrawRecord) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *DrawRecord) GetAlgorithmVersion() string {
+	if x != nil {
+		return x.AlgorithmVersion
+	}
+	return ""
+}
+
+func (x *DrawRecord) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ListDrawsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Draws []*DrawRecord          `protobuf:"bytes,1,rep,name=Draws,proto3" json:"Draws,omitempty"`
+	// NextPageToken is empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDrawsReply) Reset() {
+	*x = ListDrawsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDrawsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDrawsReply) ProtoMessage() {}
+
+func (x *ListDrawsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDrawsReply.ProtoReflect.Descriptor instead.
+func (*ListDrawsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListDrawsReply) GetDraws() []*DrawRecord {
+	if x != nil {
+		return x.Draws
+	}
+	return nil
+}
+
+func (x *ListDrawsReply) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ExportDrawsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// From and To bound the time of draws to [From, To), in Unix nanoseconds,
+	// 0 leaves a bound open.
+	From          int64        `protobuf:"varint,1,opt,name=From,proto3" json:"From,omitempty"`
+	To            int64        `protobuf:"varint,2,opt,name=To,proto3" json:"To,omitempty"`
+	Format        ExportFormat `protobuf:"varint,3,opt,name=Format,proto3,enum=random.ExportFormat" json:"Format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDrawsRequest) Reset() {
+	*x = ExportDrawsRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDrawsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDrawsRequest) ProtoMessage() {}
+
+func (x *ExportDrawsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDrawsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDrawsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ExportDrawsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *ExportDrawsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *ExportDrawsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+type ExportDrawsReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Chunk is the next part of the file.
+	Chunk         []byte `protobuf:"bytes,1,opt,name=Chunk,proto3" json:"Chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDrawsReply) Reset() {
+	*x = ExportDrawsReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDrawsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDrawsReply) ProtoMessage() {}
+
+func (x *ExportDrawsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDrawsReply.ProtoReflect.Descriptor instead.
+func (*ExportDrawsReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ExportDrawsReply) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{81}
+}
+
+type QuotaUsage struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Window QuotaWindow            `protobuf:"varint,1,opt,name=Window,proto3,enum=random.QuotaWindow" json:"Window,omitempty"`
+	// Used is the number of draws made in the window, every message of a
+	// stream counts as a draw.
+	Used int64 `protobuf:"varint,2,opt,name=Used,proto3" json:"Used,omitempty"`
+	// Limit is the number of draws allowed in the window, 0 when unlimited.
+	Limit int64 `protobuf:"varint,3,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	// ResetsAt is the Unix time in nanoseconds at which the window ends.
+	ResetsAt      int64 `protobuf:"varint,4,opt,name=ResetsAt,proto3" json:"ResetsAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuotaUsage) Reset() {
+	*x = QuotaUsage{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotaUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsage) ProtoMessage() {}
+
+func (x *QuotaUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsage.ProtoReflect.Descriptor instead.
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *QuotaUsage) GetWindow() QuotaWindow {
+	if x != nil {
+		return x.Window
+	}
+	return QuotaWindow_QUOTA_WINDOW_UNSPECIFIED
+}
+
+func (x *QuotaUsage) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *QuotaUsage) GetResetsAt() int64 {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return 0
+}
+
+type GetUsageReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Client is the name of the caller in the server config, or its IP.
+	Client        string        `protobuf:"bytes,1,opt,name=Client,proto3" json:"Client,omitempty"`
+	Tier          string        `protobuf:"bytes,2,opt,name=Tier,proto3" json:"Tier,omitempty"`
+	Quotas        []*QuotaUsage `protobuf:"bytes,3,rep,name=Quotas,proto3" json:"Quotas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUsageReply) Reset() {
+	*x = GetUsageReply{}
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUsageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReply) ProtoMessage() {}
+
+func (x *GetUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_pb_random_random_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReply.ProtoReflect.Descriptor instead.
+func (*GetUsageReply) Descriptor() ([]byte, []int) {
+	return file_api_v1_pb_random_random_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetUsageReply) GetClient() string {
+	if x != nil {
+		return x.Client
+	}
+	return ""
+}
+
+func (x *GetUsageReply) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *GetUsageReply) GetQuotas() []*QuotaUsage {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+var File_api_v1_pb_random_random_proto protoreflect.FileDescriptor
+
+var file_api_v1_pb_random_random_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x06, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x93, 0x02, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53,
+	0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65,
+	0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64,
+	0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x8d, 0x01, 0xba, 0x48, 0x89, 0x01, 0x1a,
+	0x86, 0x01, 0x0a, 0x18, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x2e, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x12, 0x45, 0x53, 0x65,
+	0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71,
+	0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x33, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20,
+	0x4d, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x43,
+	0x55, 0x52, 0x45, 0x1a, 0x23, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d,
+	0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x53, 0x65, 0x65, 0x64,
+	0x4e, 0x75, 0x6d, 0x20, 0x3e, 0x3d, 0x20, 0x33, 0x22, 0xc9, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0x55, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x83, 0x01, 0x0a, 0x18,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64,
+	0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1d, 0x0a, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x22, 0xc1, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x6e, 0x64,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x67, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x0a, 0x08, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x03,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x52, 0x08, 0x53,
+	0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x73, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0xc4,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x78, 0x0a, 0x10, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x65,
+	0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64,
+	0x4e, 0x75, 0x6d, 0x12, 0x18, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x36, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28,
+	0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x08, 0x49, 0x6e,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x48, 0x00,
+	0x52, 0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x0a, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x0e, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0xd1, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x05, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x05, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xb7, 0x02, 0x0a, 0x15,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52,
+	0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x37, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x0b, 0xba,
+	0x48, 0x08, 0x92, 0x01, 0x05, 0x08, 0x01, 0x10, 0x90, 0x4e, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52,
+	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x79, 0xba, 0x48, 0x76, 0x1a,
+	0x74, 0x0a, 0x1f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x2e, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x2d, 0x41, 0x74, 0x20, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x20, 0x6f, 0x6e, 0x65,
+	0x20, 0x69, 0x74, 0x65, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20,
+	0x61, 0x20, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x20, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x1a, 0x22, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x28, 0x69, 0x2c, 0x20, 0x69, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x20, 0x3e, 0x20, 0x30, 0x29, 0x22, 0x58, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x08, 0x52, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x28, 0x0a, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x12, 0x0b, 0x40, 0x01, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0xc0, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07,
+	0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x22, 0x77, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65,
+	0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1e, 0x0a, 0x04, 0x44, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x04,
+	0x44, 0x69, 0x63, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20,
+	0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0xd9, 0x01, 0x0a, 0x09,
+	0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x08, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x04, 0x44,
+	0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x44, 0x69, 0x65, 0x52, 0x04, 0x44, 0x69, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x75, 0x0a, 0x03, 0x44, 0x69, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x69, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x53, 0x69, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x4b, 0x65, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x4b, 0x65,
+	0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x45, 0x78, 0x70, 0x6c, 0x6f, 0x64, 0x65, 0x64, 0x22, 0x89,
+	0x01, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x4d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x3a,
+	0x59, 0xba, 0x48, 0x56, 0x1a, 0x54, 0x0a, 0x15, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67,
+	0x65, 0x2e, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x12, 0x25, 0x4d,
+	0x69, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f,
+	0x20, 0x4d, 0x61, 0x78, 0x1a, 0x14, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x69, 0x6e, 0x20, 0x3c,
+	0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x61, 0x78, 0x22, 0x9f, 0x01, 0x0a, 0x0a, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x03, 0x4d, 0x69, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x07, 0xba, 0x48, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52,
+	0x03, 0x4d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x07, 0xba, 0x48, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x3a,
+	0x5b, 0xba, 0x48, 0x58, 0x1a, 0x56, 0x0a, 0x17, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x2e, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x12,
+	0x25, 0x4d, 0x69, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73,
+	0x73, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20,
+	0x74, 0x6f, 0x20, 0x4d, 0x61, 0x78, 0x1a, 0x14, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x69, 0x6e,
+	0x20, 0x3c, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x61, 0x78, 0x22, 0xbe, 0x03, 0x0a,
+	0x19, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65,
+	0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x20, 0x0a,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x01, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x06, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x06, 0x4e,
+	0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12, 0x43, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
+	0x74, 0x69, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x45,
+	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x07, 0x50, 0x6f,
+	0x69, 0x73, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x50, 0x6f, 0x69, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x50, 0x6f, 0x69, 0x73,
+	0x73, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x08, 0x42, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x42,
+	0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x42, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c, 0x12,
+	0x2e, 0x0a, 0x07, 0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x55, 0x6e, 0x69, 0x66, 0x6f, 0x72, 0x6d, 0x12,
+	0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x42, 0x15, 0x0a, 0x0c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0xae, 0x01,
+	0x0a, 0x17, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x5b,
+	0x0a, 0x12, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x4d, 0x65, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x07, 0xba, 0x48, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x04, 0x4d, 0x65, 0x61,
+	0x6e, 0x12, 0x28, 0x0a, 0x06, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x12, 0x0b, 0x40, 0x01, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x52, 0x06, 0x53, 0x74, 0x64, 0x44, 0x65, 0x76, 0x22, 0x3f, 0x0a, 0x17, 0x45,
+	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x52, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x10, 0xba, 0x48, 0x0d, 0x12, 0x0b, 0x40, 0x01, 0x21, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x04, 0x52, 0x61, 0x74, 0x65, 0x22, 0x46, 0x0a, 0x13,
+	0x50, 0x6f, 0x69, 0x73, 0x73, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x06, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0xa2, 0x94,
+	0x1a, 0x6d, 0x42, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x4c, 0x61,
+	0x6d, 0x62, 0x64, 0x61, 0x22, 0x5b, 0x0a, 0x14, 0x42, 0x69, 0x6e, 0x6f, 0x6d, 0x69, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x01,
+	0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x22, 0x09, 0x18, 0x80,
+	0xa0, 0x94, 0xa5, 0x8d, 0x1d, 0x28, 0x00, 0x52, 0x01, 0x4e, 0x12, 0x25, 0x0a, 0x01, 0x50, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x01,
+	0x50, 0x22, 0xed, 0x02, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x65,
+	0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64,
+	0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12,
+	0x22, 0x0a, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0x80, 0x20, 0x28, 0x01, 0x52, 0x06, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x3a, 0x8c, 0x01, 0xba, 0x48, 0x88, 0x01, 0x1a, 0x85, 0x01, 0x0a, 0x17, 0x67, 0x65,
+	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x2e, 0x73, 0x65, 0x65,
+	0x64, 0x5f, 0x6e, 0x75, 0x6d, 0x12, 0x45, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74,
+	0x68, 0x61, 0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20,
+	0x33, 0x20, 0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73,
+	0x20, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x1a, 0x23, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x3e, 0x3d, 0x20,
+	0x33, 0x22, 0xf2, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x08, 0x45,
+	0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xe3, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x55,
+	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65,
+	0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65,
+	0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18,
+	0xe8, 0x07, 0x28, 0x01, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x3a, 0x87, 0x01, 0xba, 0x48, 0x83, 0x01, 0x1a, 0x80, 0x01, 0x0a, 0x12, 0x67, 0x65,
+	0x74, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x2e, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6e, 0x75, 0x6d,
+	0x12, 0x45, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62,
+	0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e, 0x20, 0x6f,
+	0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x33, 0x20, 0x75, 0x6e, 0x6c,
+	0x65, 0x73, 0x73, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x4d, 0x4f, 0x44, 0x45,
+	0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x1a, 0x23, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x6f,
+	0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x3e, 0x3d, 0x20, 0x33, 0x22, 0xf1, 0x01, 0x0a,
+	0x0d, 0x47, 0x65, 0x74, 0x55, 0x55, 0x49, 0x44, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x55, 0x55, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x55,
+	0x55, 0x49, 0x44, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x55,
+	0x55, 0x49, 0x44, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0xac, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a,
+	0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x06, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a,
+	0x05, 0x18, 0x80, 0x08, 0x28, 0x01, 0x52, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x25,
+	0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x87, 0x01, 0xba, 0x48, 0x83, 0x01, 0x1a, 0x80, 0x01, 0x0a,
+	0x12, 0x67, 0x65, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x2e, 0x73, 0x65, 0x65, 0x64, 0x5f,
+	0x6e, 0x75, 0x6d, 0x12, 0x45, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x6d, 0x75, 0x73,
+	0x74, 0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61,
+	0x6e, 0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x33, 0x20,
+	0x75, 0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x1a, 0x23, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x3e, 0x3d, 0x20, 0x33, 0x22,
+	0xc2, 0x01, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x22, 0xb6, 0x03, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2a, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x24, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x48,
+	0x00, 0x52, 0x07, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x24, 0x0a, 0x07, 0x43, 0x68,
+	0x61, 0x72, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05,
+	0x72, 0x03, 0x18, 0x80, 0x02, 0x48, 0x00, 0x52, 0x07, 0x43, 0x68, 0x61, 0x72, 0x73, 0x65, 0x74,
+	0x12, 0x22, 0x0a, 0x06, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0x80, 0x08, 0x28, 0x00, 0x52, 0x06, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02,
+	0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x8e, 0x01,
+	0xba, 0x48, 0x8a, 0x01, 0x1a, 0x87, 0x01, 0x0a, 0x19, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x61, 0x6e,
+	0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6e,
+	0x75, 0x6d, 0x12, 0x45, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74,
+	0x20, 0x62, 0x65, 0x20, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x20, 0x74, 0x68, 0x61, 0x6e,
+	0x20, 0x6f, 0x72, 0x20, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x20, 0x74, 0x6f, 0x20, 0x33, 0x20, 0x75,
+	0x6e, 0x6c, 0x65, 0x73, 0x73, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x69, 0x73, 0x20, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x55, 0x52, 0x45, 0x1a, 0x23, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x32, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69,
+	0x73, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x20, 0x3e, 0x3d, 0x20, 0x33, 0x42, 0x0d,
+	0x0a, 0x04, 0x53, 0x70, 0x65, 0x63, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22, 0xcc, 0x01,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x20, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x4d, 0x6f, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xa3, 0x02, 0x0a,
+	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28,
+	0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x06, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x42, 0x0a, 0xba,
+	0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x40, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x21, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x01, 0x52, 0x05, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20,
+	0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a, 0x65, 0xba, 0x48, 0x62,
+	0x1a, 0x60, 0x0a, 0x1d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x2e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x12, 0x1a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x20, 0x6d,
+	0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x1a, 0x23, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2e, 0x6d, 0x61, 0x70, 0x28, 0x66,
+	0x2c, 0x20, 0x66, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x2e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x28, 0x29, 0x22, 0xeb, 0x02, 0x0a, 0x09, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63,
+	0x12, 0x37, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0xba, 0x48, 0x20, 0x72, 0x1e, 0x10, 0x01, 0x18, 0x40, 0x32, 0x18, 0x5e, 0x5b, 0x41, 0x2d, 0x5a,
+	0x61, 0x2d, 0x7a, 0x5f, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f,
+	0x5d, 0x2a, 0x24, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x50, 0x65, 0x72,
+	0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x0a, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2e,
+	0x0a, 0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x31,
+	0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x48, 0x00, 0x52, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x26, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x48, 0x00, 0x52, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x26, 0x0a, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x54, 0x79, 0x70, 0x65, 0x48, 0x00, 0x52, 0x04, 0x55, 0x55, 0x49,
+	0x44, 0x42, 0x0d, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01,
+	0x22, 0x10, 0x0a, 0x0e, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x2f, 0x0a, 0x09, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x22, 0x0a, 0x06, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0xd8, 0x01, 0x01, 0x72, 0x02, 0x68, 0x01, 0x52, 0x06, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x35, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23,
+	0xba, 0x48, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x32, 0x7d, 0x24, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x35, 0x0a, 0x03, 0x4d, 0x61, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xba, 0x48, 0x20, 0x72, 0x1e, 0x32, 0x1c, 0x5e, 0x5b,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d,
+	0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x24, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x3a,
+	0x4e, 0xba, 0x48, 0x4b, 0x1a, 0x49, 0x0a, 0x16, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x2e, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x12, 0x19,
+	0x4d, 0x69, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x62, 0x65, 0x20,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x20, 0x4d, 0x61, 0x78, 0x1a, 0x14, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x4d, 0x69, 0x6e, 0x20, 0x3c, 0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x61, 0x78, 0x22,
+	0x36, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x12, 0xba, 0x48, 0x0f,
+	0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xe8, 0x07, 0x22, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52,
+	0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x0a, 0x0a, 0x08, 0x55, 0x55, 0x49, 0x44, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x14, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x2a, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x2f,
+	0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x57, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x06, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x03, 0x49,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x03, 0x49, 0x6e, 0x74, 0x42,
+	0x07, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x84, 0x03, 0x0a, 0x0e, 0x53, 0x68, 0x75,
+	0x66, 0x66, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53,
+	0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48,
+	0x04, 0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x2c,
+	0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x48, 0x00, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x04,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x22,
+	0x06, 0x18, 0xa0, 0x8d, 0x06, 0x28, 0x01, 0x48, 0x00, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x27, 0x0a, 0x0a, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x0a, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x3a,
+	0x98, 0x01, 0xba, 0x48, 0x94, 0x01, 0x1a, 0x91, 0x01, 0x0a, 0x13, 0x73, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x2e, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x2e,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20,
+	0x6e, 0x6f, 0x74, 0x20, 0x65, 0x78, 0x63, 0x65, 0x65, 0x64, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70,
+	0x6f, 0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x1a, 0x4a,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x20,
+	0x3c, 0x3d, 0x20, 0x28, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x29, 0x20, 0x3f, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x29, 0x20, 0x3a, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x29, 0x42, 0x13, 0x0a, 0x0a, 0x50, 0x6f,
+	0x70, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22,
+	0x3a, 0x0a, 0x0c, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x2a, 0x0a, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x12, 0xba, 0x48, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0x90, 0x4e, 0x22, 0x05, 0x72, 0x03,
+	0x18, 0x80, 0x08, 0x52, 0x06, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xe5, 0x01, 0x0a, 0x0c,
+	0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x07, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67,
+	0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x22, 0xa3, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75,
+	0x6d, 0x12, 0x1d, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0xc1, 0x01, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x03, 0x52, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x62, 0x0a,
+	0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e,
+	0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28,
+	0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba,
+	0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x22, 0xb2, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c,
+	0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x12, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x6c, 0x0a, 0x09, 0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x22, 0x43, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0b, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xff, 0x01,
+	0x0a, 0x0b, 0x44, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12, 0x2f, 0x0a,
+	0x06, 0x52, 0x65, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x06, 0x52, 0x65, 0x73, 0x65, 0x65, 0x64, 0x12, 0x32,
+	0x0a, 0x07, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x07, 0x4e, 0x65, 0x78, 0x74, 0x49,
+	0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x53, 0x6b, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x6b, 0x69, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x04, 0x53, 0x6b, 0x69, 0x70, 0x42, 0x10, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x05, 0xba, 0x48, 0x02, 0x08, 0x01, 0x22,
+	0x59, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x65, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52, 0x07, 0x53, 0x65, 0x65, 0x64,
+	0x4e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52,
+	0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x22, 0x32, 0x0a, 0x0e, 0x4e, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x05,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x66,
+	0x0a, 0x12, 0x4e, 0x65, 0x78, 0x74, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x22, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52,
+	0x05, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x28, 0x0a, 0x0b, 0x53, 0x6b, 0x69, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x01, 0x4e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0xba, 0x48, 0x08, 0x22, 0x06, 0x18, 0xc0, 0x84, 0x3d, 0x28, 0x00, 0x52, 0x01, 0x4e,
+	0x22, 0xc7, 0x01, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03,
+	0x52, 0x07, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a,
+	0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x79, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x2a,
+	0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x0a,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x05, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x05, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x09, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x34, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x22,
+	0x5f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0xf0, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x03, 0x52,
+	0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x25, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04,
+	0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12,
+	0x21, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x01, 0x52, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x02, 0x50, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x50, 0x69, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x48, 0x00, 0x52, 0x02, 0x50, 0x69, 0x12, 0x36, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65,
+	0x67, 0x72, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c,
+	0x42, 0x11, 0x0a, 0x08, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x05, 0xba, 0x48,
+	0x02, 0x08, 0x01, 0x22, 0x0c, 0x0a, 0x0a, 0x50, 0x69, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
+	0x65, 0x22, 0xc9, 0x01, 0x0a, 0x10, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72,
+	0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x4d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x12, 0x02, 0x40, 0x01, 0x52, 0x03, 0x4d, 0x69, 0x6e, 0x12, 0x19, 0x0a,
+	0x03, 0x4d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x07, 0xba, 0x48, 0x04, 0x12,
+	0x02, 0x40, 0x01, 0x52, 0x03, 0x4d, 0x61, 0x78, 0x3a, 0x53, 0xba, 0x48, 0x50, 0x1a, 0x4e, 0x0a,
+	0x1c, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x2e, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x12, 0x19, 0x4d,
+	0x69, 0x6e, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x6c, 0x65, 0x73, 0x73, 0x20,
+	0x74, 0x68, 0x61, 0x6e, 0x20, 0x4d, 0x61, 0x78, 0x1a, 0x13, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d,
+	0x69, 0x6e, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x4d, 0x61, 0x78, 0x22, 0x87, 0x03,
+	0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x05, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f,
+	0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x53, 0x74, 0x64,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x46, 0x69, 0x6e,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x22, 0x2f, 0x0a, 0x0e, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x03, 0x4a, 0x6f, 0x62,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x2f, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x4a, 0x6f, 0x62,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x2c, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a, 0x03, 0x4a, 0x6f, 0x62, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4a,
+	0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x32, 0x0a, 0x10, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72,
+	0x03, 0xb0, 0x01, 0x01, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x2f, 0x0a, 0x0e, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a,
+	0x03, 0x4a, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x03, 0x4a, 0x6f, 0x62, 0x22, 0x43, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0x30, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4a, 0x6f, 0x62, 0x52, 0x04, 0x4a,
+	0x6f, 0x62, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x77,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52,
+	0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17, 0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x18,
+	0x0a, 0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x23, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x18, 0x40, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x12, 0x26, 0x0a,
+	0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8,
+	0x07, 0x28, 0x00, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x25, 0x0a,
+	0x09, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x40, 0x52, 0x09, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x3a, 0x6b, 0xba, 0x48, 0x68, 0x1a, 0x66, 0x0a, 0x15, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x64, 0x72, 0x61, 0x77, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x16, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65,
+	0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20, 0x54, 0x6f, 0x1a, 0x35, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x54, 0x6f, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x54,
+	0x6f, 0x22, 0x94, 0x02, 0x0a, 0x0a, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x53, 0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x53,
+	0x65, 0x65, 0x64, 0x4e, 0x75, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69,
+	0x74, 0x68, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x6c, 0x67, 0x6f, 0x72,
+	0x69, 0x74, 0x68, 0x6d, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x60, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x05, 0x44, 0x72,
+	0x61, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x05, 0x44,
+	0x72, 0x61, 0x77, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x4e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf1, 0x01, 0x0a, 0x12, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x04, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x17,
+	0x0a, 0x02, 0x54, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22,
+	0x02, 0x28, 0x00, 0x52, 0x02, 0x54, 0x6f, 0x12, 0x36, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a,
+	0x6d, 0xba, 0x48, 0x6a, 0x1a, 0x68, 0x0a, 0x17, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x64,
+	0x72, 0x61, 0x77, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x16, 0x46, 0x72, 0x6f, 0x6d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x20, 0x54, 0x6f, 0x1a, 0x35, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x46, 0x72,
+	0x6f, 0x6d, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x54, 0x6f, 0x20, 0x3d, 0x3d, 0x20, 0x30, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x46, 0x72, 0x6f, 0x6d, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x54, 0x6f, 0x22, 0x28,
+	0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x7f, 0x0a, 0x0a, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06,
+	0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x12, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x55, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x52, 0x65, 0x73, 0x65, 0x74, 0x73, 0x41, 0x74, 0x22, 0x67, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a,
+	0x06, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x73, 0x2a, 0x3e, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a,
+	0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x45, 0x44,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x45, 0x43,
+	0x55, 0x52, 0x45, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x08, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45,
+	0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x48, 0x45, 0x58, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x45, 0x4e, 0x43, 0x4f, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x42, 0x41, 0x53, 0x45, 0x36, 0x34,
+	0x10, 0x02, 0x2a, 0x53, 0x0a, 0x0b, 0x55, 0x55, 0x49, 0x44, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x34, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x37, 0x10, 0x07, 0x2a, 0x9a, 0x01, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45,
+	0x55, 0x45, 0x44, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13,
+	0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x45,
+	0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x4a, 0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x4a,
+	0x4f, 0x42, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x05, 0x2a, 0x5e, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x58,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4e, 0x44, 0x4a, 0x53,
+	0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x5d, 0x0a, 0x0b, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0x1c, 0x0a, 0x18, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x57, 0x49, 0x4e,
+	0x44, 0x4f, 0x57, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x16, 0x0a, 0x12, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f,
+	0x57, 0x5f, 0x44, 0x41, 0x49, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x51, 0x55, 0x4f,
+	0x54, 0x41, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x4c,
+	0x59, 0x10, 0x02, 0x32, 0xd2, 0x14, 0x0a, 0x0d, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x12, 0x72, 0x0a, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61,
+	0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x61, 0x6e, 0x64, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01,
+	0x2a, 0x22, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2f, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x78, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x23, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
+	0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x49, 0x6e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x64, 0x0a,
+	0x0e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x1d, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65,
+	0x64, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64,
+	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x6f, 0x69,
+	0x63, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x6c, 0x12, 0x13, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31,
+	0x2f, 0x72, 0x6f, 0x6c, 0x6c, 0x12, 0x70, 0x0a, 0x12, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x69, 0x73, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x59, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x61, 0x6e, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x55, 0x55, 0x49, 0x44, 0x73, 0x12, 0x17,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x55, 0x49, 0x44, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x55, 0x49, 0x44, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x75, 0x69, 0x64,
+	0x73, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x61, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x1d, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x69, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22,
+	0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x30, 0x01, 0x12, 0x4f,
+	0x0a, 0x07, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x53, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x14, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x68, 0x75, 0x66, 0x66,
+	0x6c, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a,
+	0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x75, 0x66, 0x66, 0x6c, 0x65, 0x12,
+	0x6c, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x41, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x41, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x6a, 0x0a,
+	0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1e, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x19,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x04, 0x4e, 0x65, 0x78,
+	0x74, 0x12, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x4e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49,
+	0x44, 0x7d, 0x2f, 0x6e, 0x65, 0x78, 0x74, 0x12, 0x72, 0x0a, 0x0e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x2a, 0x1c, 0x2f,
+	0x76, 0x31, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x7d, 0x12, 0x3c, 0x0a, 0x0b, 0x44,
+	0x72, 0x61, 0x77, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x13, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a,
+	0x12, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x12, 0x6e, 0x0a, 0x10, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x2e,
+	0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x5e, 0x0a, 0x04, 0x44, 0x72, 0x61,
+	0x77, 0x12, 0x13, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x44, 0x72, 0x61, 0x77, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x44, 0x72, 0x61, 0x77, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x7b, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x44, 0x7d, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x12, 0x5e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x2e, 0x72, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x2d, 0x6b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x09, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d,
+	0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x4e, 0x0a,
+	0x06, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x12, 0x15, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31,
+	0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x7d, 0x12, 0x61, 0x0a,
+	0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x12, 0x18, 0x2e, 0x72, 0x61, 0x6e,
+	0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x22, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1c, 0x3a, 0x01, 0x2a, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62,
+	0x73, 0x2f, 0x7b, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x7d, 0x3a, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x12, 0x4c, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x17, 0x2e, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x10, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x50,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x12, 0x18, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x72, 0x61, 0x77, 0x73,
+	0x12, 0x47, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73, 0x12,
+	0x1a, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x72, 0x61, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x72, 0x61, 0x77, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4d, 0x0a, 0x08, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f,
+	0x76, 0x31, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x42, 0x97, 0x01, 0x0a, 0x0a, 0x63, 0x6f, 0x6d,
+	0x2e, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x0b, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x44, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x6d, 0x69, 0x6e, 0x68, 0x74, 0x68, 0x6f, 0x6e, 0x67, 0x35, 0x38, 0x32, 0x30,
+	0x30, 0x30, 0x2f, 0x73, 0x6f, 0x61, 0x2d, 0x34, 0x30, 0x34, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x62, 0x2f, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0xa2, 0x02, 0x03, 0x52,
+	0x58, 0x58, 0xaa, 0x02, 0x06, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0xca, 0x02, 0x06, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0xe2, 0x02, 0x12, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x06, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_api_v1_pb_random_random_proto_rawDescOnce sync.Once
+	file_api_v1_pb_random_random_proto_rawDescData []byte
+)
+
+func file_api_v1_pb_random_random_proto_rawDescGZIP() []byte {
+	file_api_v1_pb_random_random_proto_rawDescOnce.Do(func() {
+		file_api_v1_pb_random_random_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_pb_random_random_proto_rawDesc), len(file_api_v1_pb_random_random_proto_rawDesc)))
+	})
+	return file_api_v1_pb_random_random_proto_rawDescData
+}
+
+var file_api_v1_pb_random_random_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_api_v1_pb_random_random_proto_msgTypes = make([]protoimpl.MessageInfo, 84)
+var file_api_v1_pb_random_random_proto_goTypes = []any{
+	(Mode)(0),                           // 0: random.Mode
+	(Encoding)(0),                       // 1: random.Encoding
+	(UUIDVersion)(0),                    // 2: random.UUIDVersion
+	(JobState)(0),                       // 3: random.JobState
+	(ExportFormat)(0),                   // 4: random.ExportFormat
+	(QuotaWindow)(0),                    // 5: random.QuotaWindow
+	(*GetRandNumberRequest)(nil),        // 6: random.GetRandNumberRequest
+	(*GetRandNumberReply)(nil),          // 7: random.GetRandNumberReply
+	(*Signature)(nil),                   // 8: random.Signature
+	(*StreamRandNumbersRequest)(nil),    // 9: random.StreamRandNumbersRequest
+	(*StreamRandNumbersReply)(nil),      // 10: random.StreamRandNumbersReply
+	(*GetRandNumbersRequest)(nil),       // 11: random.GetRandNumbersRequest
+	(*GetRandNumbersReply)(nil),         // 12: random.GetRandNumbersReply
+	(*RandNumberResult)(nil),            // 13: random.RandNumberResult
+	(*Status)(nil),                      // 14: random.Status
+	(*GetRandNumberInRangeRequest)(nil), // 15: random.GetRandNumberInRangeRequest
+	(*GetRandNumberInRangeReply)(nil),   // 16: random.GetRandNumberInRangeReply
+	(*WeightedChoiceRequest)(nil),       // 17: random.WeightedChoiceRequest
+	(*WeightedItem)(nil),                // 18: random.WeightedItem
+	(*WeightedChoiceReply)(nil),         // 19: random.WeightedChoiceReply
+	(*RollRequest)(nil),                 // 20: random.RollRequest
+	(*RollReply)(nil),                   // 21: random.RollReply
+	(*Die)(nil),                         // 22: random.Die
+	(*IntRange)(nil),                    // 23: random.IntRange
+	(*FloatRange)(nil),                  // 24: random.FloatRange
+	(*SampleDistributionRequest)(nil),   // 25: random.SampleDistributionRequest
+	(*SampleDistributionReply)(nil),     // 26: random.SampleDistributionReply
+	(*NormalDistribution)(nil),          // 27: random.NormalDistribution
+	(*ExponentialDistribution)(nil),     // 28: random.ExponentialDistribution
+	(*PoissonDistribution)(nil),         // 29: random.PoissonDistribution
+	(*BinomialDistribution)(nil),        // 30: random.BinomialDistribution
+	(*GetRandBytesRequest)(nil),         // 31: random.GetRandBytesRequest
+	(*GetRandBytesReply)(nil),           // 32: random.GetRandBytesReply
+	(*GetUUIDsRequest)(nil),             // 33: random.GetUUIDsRequest
+	(*GetUUIDsReply)(nil),               // 34: random.GetUUIDsReply
+	(*GetTokenRequest)(nil),             // 35: random.GetTokenRequest
+	(*GetTokenReply)(nil),               // 36: random.GetTokenReply
+	(*GetRandStringsRequest)(nil),       // 37: random.GetRandStringsRequest
+	(*GetRandStringsReply)(nil),         // 38: random.GetRandStringsReply
+	(*GenerateRecordsRequest)(nil),      // 39: random.GenerateRecordsRequest
+	(*FieldSpec)(nil),                   // 40: random.FieldSpec
+	(*PersonNameType)(nil),              // 41: random.PersonNameType
+	(*EmailType)(nil),                   // 42: random.EmailType
+	(*DateRange)(nil),                   // 43: random.DateRange
+	(*EnumType)(nil),                    // 44: random.EnumType
+	(*UUIDType)(nil),                    // 45: random.UUIDType
+	(*GenerateRecordsReply)(nil),        // 46: random.GenerateRecordsReply
+	(*FieldValue)(nil),                  // 47: random.FieldValue
+	(*ShuffleRequest)(nil),              // 48: random.ShuffleRequest
+	(*ShuffleItems)(nil),                // 49: random.ShuffleItems
+	(*ShuffleReply)(nil),                // 50: random.ShuffleReply
+	(*GetRandNumberAtRequest)(nil),      // 51: random.GetRandNumberAtRequest
+	(*GetRandNumberAtReply)(nil),        // 52: random.GetRandNumberAtReply
+	(*CreateGeneratorRequest)(nil),      // 53: random.CreateGeneratorRequest
+	(*CreateGeneratorReply)(nil),        // 54: random.CreateGeneratorReply
+	(*NextRequest)(nil),                 // 55: random.NextRequest
+	(*NextReply)(nil),                   // 56: random.NextReply
+	(*CloseGeneratorRequest)(nil),       // 57: random.CloseGeneratorRequest
+	(*CloseGeneratorReply)(nil),         // 58: random.CloseGeneratorReply
+	(*DrawCommand)(nil),                 // 59: random.DrawCommand
+	(*ReseedCommand)(nil),               // 60: random.ReseedCommand
+	(*NextIntCommand)(nil),              // 61: random.NextIntCommand
+	(*NextInRangeCommand)(nil),          // 62: random.NextInRangeCommand
+	(*SkipCommand)(nil),                 // 63: random.SkipCommand
+	(*DrawResult)(nil),                  // 64: random.DrawResult
+	(*CreateCommitmentRequest)(nil),     // 65: random.CreateCommitmentRequest
+	(*CreateCommitmentReply)(nil),       // 66: random.CreateCommitmentReply
+	(*DrawRequest)(nil),                 // 67: random.DrawRequest
+	(*DrawReply)(nil),                   // 68: random.DrawReply
+	(*GetPublicKeyRequest)(nil),         // 69: random.GetPublicKeyRequest
+	(*GetPublicKeyReply)(nil),           // 70: random.GetPublicKeyReply
+	(*SubmitJobRequest)(nil),            // 71: random.SubmitJobRequest
+	(*PiEstimate)(nil),                  // 72: random.PiEstimate
+	(*IntegralEstimate)(nil),            // 73: random.IntegralEstimate
+	(*Job)(nil),                         // 74: random.Job
+	(*SubmitJobReply)(nil),              // 75: random.SubmitJobReply
+	(*GetJobRequest)(nil),               // 76: random.GetJobRequest
+	(*GetJobReply)(nil),                 // 77: random.GetJobReply
+	(*CancelJobRequest)(nil),            // 78: random.CancelJobRequest
+	(*CancelJobReply)(nil),              // 79: random.CancelJobReply
+	(*ListJobsRequest)(nil),             // 80: random.ListJobsRequest
+	(*ListJobsReply)(nil),               // 81: random.ListJobsReply
+	(*ListDrawsRequest)(nil),            // 82: random.ListDrawsRequest
+	(*DrawRecord)(nil),                  // 83: random.DrawRecord
+	(*ListDrawsReply)(nil),              // 84: random.ListDrawsReply
+	(*ExportDrawsRequest)(nil),          // 85: random.ExportDrawsRequest
+	(*ExportDrawsReply)(nil),            // 86: random.ExportDrawsReply
+	(*GetUsageRequest)(nil),             // 87: random.GetUsageRequest
+	(*QuotaUsage)(nil),                  // 88: random.QuotaUsage
+	(*GetUsageReply)(nil),               // 89: random.GetUsageReply
+}
+var file_api_v1_pb_random_random_proto_depIdxs = []int32{
+	0,  // 0: random.GetRandNumberRequest.Mode:type_name -> random.Mode
+	0,  // 1: random.GetRandNumberReply.Mode:type_name -> random.Mode
+	8,  // 2: random.GetRandNumberReply.Signature:type_name -> random.Signature
+	8,  // 3: random.StreamRandNumbersReply.Signature:type_name -> random.Signature
+	13, // 4: random.GetRandNumbersReply.Results:type_name -> random.RandNumberResult
+	8,  // 5: random.GetRandNumbersReply.Signature:type_name -> random.Signature
+	14, // 6: random.RandNumberResult.Error:type_name -> random.Status
+	23, // 7: random.GetRandNumberInRangeRequest.IntRange:type_name -> random.IntRange
+	24, // 8: random.GetRandNumberInRangeRequest.FloatRange:type_name -> random.FloatRange
+	8,  // 9: random.GetRandNumberInRangeReply.Signature:type_name -> random.Signature
+	18, // 10: random.WeightedChoiceRequest.Items:type_name -> random.WeightedItem
+	8,  // 11: random.WeightedChoiceReply.Signature:type_name -> random.Signature
+	22, // 12: random.RollReply.Dice:type_name -> random.Die
+	8,  // 13: random.RollReply.Signature:type_name -> random.Signature
+	27, // 14: random.SampleDistributionRequest.Normal:type_name -> random.NormalDistribution
+	28, // 15: random.SampleDistributionRequest.Exponential:type_name -> random.ExponentialDistribution
+	29, // 16: random.SampleDistributionRequest.Poisson:type_name -> random.PoissonDistribution
+	30, // 17: random.SampleDistributionRequest.Binomial:type_name -> random.BinomialDistribution
+	24, // 18: random.SampleDistributionRequest.Uniform:type_name -> random.FloatRange
+	8,  // 19: random.SampleDistributionReply.Signature:type_name -> random.Signature
+	0,  // 20: random.GetRandBytesRequest.Mode:type_name -> random.Mode
+	1,  // 21: random.GetRandBytesRequest.Encoding:type_name -> random.Encoding
+	1,  // 22: random.GetRandBytesReply.Encoding:type_name -> random.Encoding
+	0,  // 23: random.GetRandBytesReply.Mode:type_name -> random.Mode
+	8,  // 24: random.GetRandBytesReply.Signature:type_name -> random.Signature
+	0,  // 25: random.GetUUIDsRequest.Mode:type_name -> random.Mode
+	2,  // 26: random.GetUUIDsRequest.Version:type_name -> random.UUIDVersion
+	2,  // 27: random.GetUUIDsReply.Version:type_name -> random.UUIDVersion
+	0,  // 28: random.GetUUIDsReply.Mode:type_name -> random.Mode
+	8,  // 29: random.GetUUIDsReply.Signature:type_name -> random.Signature
+	0,  // 30: random.GetTokenRequest.Mode:type_name -> random.Mode
+	0,  // 31: random.GetTokenReply.Mode:type_name -> random.Mode
+	8,  // 32: random.GetTokenReply.Signature:type_name -> random.Signature
+	0,  // 33: random.GetRandStringsRequest.Mode:type_name -> random.Mode
+	0,  // 34: random.GetRandStringsReply.Mode:type_name -> random.Mode
+	8,  // 35: random.GetRandStringsReply.Signature:type_name -> random.Signature
+	40, // 36: random.GenerateRecordsRequest.Fields:type_name -> random.FieldSpec
+	41, // 37: random.FieldSpec.PersonName:type_name -> random.PersonNameType
+	42, // 38: random.FieldSpec.Email:type_name -> random.EmailType
+	23, // 39: random.FieldSpec.IntRange:type_name -> random.IntRange
+	43, // 40: random.FieldSpec.DateRange:type_name -> random.DateRange
+	44, // 41: random.FieldSpec.Enum:type_name -> random.EnumType
+	45, // 42: random.FieldSpec.UUID:type_name -> random.UUIDType
+	47, // 43: random.GenerateRecordsReply.Fields:type_name -> random.FieldValue
+	8,  // 44: random.GenerateRecordsReply.Signature:type_name -> random.Signature
+	49, // 45: random.ShuffleRequest.Items:type_name -> random.ShuffleItems
+	8,  // 46: random.ShuffleReply.Signature:type_name -> random.Signature
+	8,  // 47: random.GetRandNumberAtReply.Signature:type_name -> random.Signature
+	8,  // 48: random.NextReply.Signature:type_name -> random.Signature
+	60, // 49: random.DrawCommand.Reseed:type_name -> random.ReseedCommand
+	61, // 50: random.DrawCommand.NextInt:type_name -> random.NextIntCommand
+	62, // 51: random.DrawCommand.NextInRange:type_name -> random.NextInRangeCommand
+	63, // 52: random.DrawCommand.Skip:type_name -> random.SkipCommand
+	23, // 53: random.NextInRangeCommand.Range:type_name -> random.IntRange
+	8,  // 54: random.DrawResult.Signature:type_name -> random.Signature
+	23, // 55: random.DrawRequest.Range:type_name -> random.IntRange
+	23, // 56: random.DrawReply.Range:type_name -> random.IntRange
+	8,  // 57: random.DrawReply.Signature:type_name -> random.Signature
+	72, // 58: random.SubmitJobRequest.Pi:type_name -> random.PiEstimate
+	73, // 59: random.SubmitJobRequest.Integral:type_name -> random.IntegralEstimate
+	3,  // 60: random.Job.State:type_name -> random.JobState
+	71, // 61: random.Job.Request:type_name -> random.SubmitJobRequest
+	74, // 62: random.SubmitJobReply.Job:type_name -> random.Job
+	74, // 63: random.GetJobReply.Job:type_name -> random.Job
+	74, // 64: random.CancelJobReply.Job:type_name -> random.Job
+	3,  // 65: random.ListJobsRequest.State:type_name -> random.JobState
+	74, // 66: random.ListJobsReply.Jobs:type_name -> random.Job
+	83, // 67: random.ListDrawsReply.Draws:type_name -> random.DrawRecord
+	4,  // 68: random.ExportDrawsRequest.Format:type_name -> random.ExportFormat
+	5,  // 69: random.QuotaUsage.Window:type_name -> random.QuotaWindow
+	88, // 70: random.GetUsageReply.Quotas:type_name -> random.QuotaUsage
+	6,  // 71: random.RandomService.GetRandNumber:input_type -> random.GetRandNumberRequest
+	9,  // 72: random.RandomService.StreamRandNumbers:input_type -> random.StreamRandNumbersRequest
+	11, // 73: random.RandomService.GetRandNumbers:input_type -> random.GetRandNumbersRequest
+	15, // 74: random.RandomService.GetRandNumberInRange:input_type -> random.GetRandNumberInRangeRequest
+	17, // 75: random.RandomService.WeightedChoice:input_type -> random.WeightedChoiceRequest
+	20, // 76: random.RandomService.Roll:input_type -> random.RollRequest
+	25, // 77: random.RandomService.SampleDistribution:input_type -> random.SampleDistributionRequest
+	31, // 78: random.RandomService.GetRandBytes:input_type -> random.GetRandBytesRequest
+	33, // 79: random.RandomService.GetUUIDs:input_type -> random.GetUUIDsRequest
+	35, // 80: random.RandomService.GetToken:input_type -> random.GetTokenRequest
+	37, // 81: random.RandomService.GetRandStrings:input_type -> random.GetRandStringsRequest
+	39, // 82: random.RandomService.GenerateRecords:input_type -> random.GenerateRecordsRequest
+	48, // 83: random.RandomService.Shuffle:input_type -> random.ShuffleRequest
+	51, // 84: random.RandomService.GetRandNumberAt:input_type -> random.GetRandNumberAtRequest
+	53, // 85: random.RandomService.CreateGenerator:input_type -> random.CreateGeneratorRequest
+	55, // 86: random.RandomService.Next:input_type -> random.NextRequest
+	57, // 87: random.RandomService.CloseGenerator:input_type -> random.CloseGeneratorRequest
+	59, // 88: random.RandomService.DrawSession:input_type -> random.DrawCommand
+	65, // 89: random.RandomService.CreateCommitment:input_type -> random.CreateCommitmentRequest
+	67, // 90: random.RandomService.Draw:input_type -> random.DrawRequest
+	69, // 91: random.RandomService.GetPublicKey:input_type -> random.GetPublicKeyRequest
+	71, // 92: random.RandomService.SubmitJob:input_type -> random.SubmitJobRequest
+	76, // 93: random.RandomService.GetJob:input_type -> random.GetJobRequest
+	78, // 94: random.RandomService.CancelJob:input_type -> random.CancelJobRequest
+	80, // 95: random.RandomService.ListJobs:input_type -> random.ListJobsRequest
+	82, // 96: random.RandomService.ListDraws:input_type -> random.ListDrawsRequest
+	85, // 97: random.RandomService.ExportDraws:input_type -> random.ExportDrawsRequest
+	87, // 98: random.RandomService.GetUsage:input_type -> random.GetUsageRequest
+	7,  // 99: random.RandomService.GetRandNumber:output_type -> random.GetRandNumberReply
+	10, // 100: random.RandomService.StreamRandNumbers:output_type -> random.StreamRandNumbersReply
+	12, // 101: random.RandomService.GetRandNumbers:output_type -> random.GetRandNumbersReply
+	16, // 102: random.RandomService.GetRandNumberInRange:output_type -> random.GetRandNumberInRangeReply
+	19, // 103: random.RandomService.WeightedChoice:output_type -> random.WeightedChoiceReply
+	21, // 104: random.RandomService.Roll:output_type -> random.RollReply
+	26, // 105: random.RandomService.SampleDistribution:output_type -> random.SampleDistributionReply
+	32, // 106: random.RandomService.GetRandBytes:output_type -> random.GetRandBytesReply
+	34, // 107: random.RandomService.GetUUIDs:output_type -> random.GetUUIDsReply
+	36, // 108: random.RandomService.GetToken:output_type -> random.GetTokenReply
+	38, // 109: random.RandomService.GetRandStrings:output_type -> random.GetRandStringsReply
+	46, // 110: random.RandomService.GenerateRecords:output_type -> random.GenerateRecordsReply
+	50, // 111: random.RandomService.Shuffle:output_type -> random.ShuffleReply
+	52, // 112: random.RandomService.GetRandNumberAt:output_type -> random.GetRandNumberAtReply
+	54, // 113: random.RandomService.CreateGenerator:output_type -> random.CreateGeneratorReply
+	56, // 114: random.RandomService.Next:output_type -> random.NextReply
+	58, // 115: random.RandomService.CloseGenerator:output_type -> random.CloseGeneratorReply
+	64, // 116: random.RandomService.DrawSession:output_type -> random.DrawResult
+	66, // 117: random.RandomService.CreateCommitment:output_type -> random.CreateCommitmentReply
+	68, // 118: random.RandomService.Draw:output_type -> random.DrawReply
+	70, // 119: random.RandomService.GetPublicKey:output_type -> random.GetPublicKeyReply
+	75, // 120: random.RandomService.SubmitJob:output_type -> random.SubmitJobReply
+	77, // 121: random.RandomService.GetJob:output_type -> random.GetJobReply
+	79, // 122: random.RandomService.CancelJob:output_type -> random.CancelJobReply
+	81, // 123: random.RandomService.ListJobs:output_type -> random.ListJobsReply
+	84, // 124: random.RandomService.ListDraws:output_type -> random.ListDrawsReply
+	86, // 125: random.RandomService.ExportDraws:output_type -> random.ExportDrawsReply
+	89, // 126: random.RandomService.GetUsage:output_type -> random.GetUsageReply
+	99, // [99:127] is the sub-list for method output_type
+	71, // [71:99] is the sub-list for method input_type
+	71, // [71:71] is the sub-list for extension type_name
+	71, // [71:71] is the sub-list for extension extendee
+	0,  // [0:71] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_pb_random_random_proto_init() }
+func file_api_v1_pb_random_random_proto_init() {
+	if File_api_v1_pb_random_random_proto != nil {
+		return
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[7].OneofWrappers = []any{
+		(*RandNumberResult_Number)(nil),
+		(*RandNumberResult_Error)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[9].OneofWrappers = []any{
+		(*GetRandNumberInRangeRequest_IntRange)(nil),
+		(*GetRandNumberInRangeRequest_FloatRange)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[10].OneofWrappers = []any{
+		(*GetRandNumberInRangeReply_Number)(nil),
+		(*GetRandNumberInRangeReply_Float)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[19].OneofWrappers = []any{
+		(*SampleDistributionRequest_Normal)(nil),
+		(*SampleDistributionRequest_Exponential)(nil),
+		(*SampleDistributionRequest_Poisson)(nil),
+		(*SampleDistributionRequest_Binomial)(nil),
+		(*SampleDistributionRequest_Uniform)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[31].OneofWrappers = []any{
+		(*GetRandStringsRequest_Pattern)(nil),
+		(*GetRandStringsRequest_Charset)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[34].OneofWrappers = []any{
+		(*FieldSpec_PersonName)(nil),
+		(*FieldSpec_Email)(nil),
+		(*FieldSpec_IntRange)(nil),
+		(*FieldSpec_DateRange)(nil),
+		(*FieldSpec_Enum)(nil),
+		(*FieldSpec_UUID)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[41].OneofWrappers = []any{
+		(*FieldValue_String_)(nil),
+		(*FieldValue_Int)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[42].OneofWrappers = []any{
+		(*ShuffleRequest_Items)(nil),
+		(*ShuffleRequest_Size)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[53].OneofWrappers = []any{
+		(*DrawCommand_Reseed)(nil),
+		(*DrawCommand_NextInt)(nil),
+		(*DrawCommand_NextInRange)(nil),
+		(*DrawCommand_Skip)(nil),
+	}
+	file_api_v1_pb_random_random_proto_msgTypes[65].OneofWrappers = []any{
+		(*SubmitJobRequest_Pi)(nil),
+		(*SubmitJobRequest_Integral)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_pb_random_random_proto_rawDesc), len(file_api_v1_pb_random_random_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      6,
+			NumMessages:   84,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_v1_pb_random_random_proto_goTypes,
 		DependencyIndexes: file_api_v1_pb_random_random_proto_depIdxs,
+		EnumInfos:         file_api_v1_pb_random_random_proto_enumTypes,
 		MessageInfos:      file_api_v1_pb_random_random_proto_msgTypes,
 	}.Build()
 	File_api_v1_pb_random_random_proto = out.File
@@ -19,7 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RandomService_GetRandNumber_FullMethodName = "/random.RandomService/GetRandNumber"
+	RandomService_GetRandNumber_FullMethodName        = "/random.RandomService/GetRandNumber"
+	RandomService_StreamRandNumbers_FullMethodName    = "/random.RandomService/StreamRandNumbers"
+	RandomService_GetRandNumbers_FullMethodName       = "/random.RandomService/GetRandNumbers"
+	RandomService_GetRandNumberInRange_FullMethodName = "/random.RandomService/GetRandNumberInRange"
+	RandomService_WeightedChoice_FullMethodName       = "/random.RandomService/WeightedChoice"
+	RandomService_Roll_FullMethodName                 = "/random.RandomService/Roll"
+	RandomService_SampleDistribution_FullMethodName   = "/random.RandomService/SampleDistribution"
+	RandomService_GetRandBytes_FullMethodName         = "/random.RandomService/GetRandBytes"
+	RandomService_GetUUIDs_FullMethodName             = "/random.RandomService/GetUUIDs"
+	RandomService_GetToken_FullMethodName             = "/random.RandomService/GetToken"
+	RandomService_GetRandStrings_FullMethodName       = "/random.RandomService/GetRandStrings"
+	RandomService_GenerateRecords_FullMethodName      = "/random.RandomService/GenerateRecords"
+	RandomService_Shuffle_FullMethodName              = "/random.RandomService/Shuffle"
+	RandomService_GetRandNumberAt_FullMethodName      = "/random.RandomService/GetRandNumberAt"
+	RandomService_CreateGenerator_FullMethodName      = "/random.RandomService/CreateGenerator"
+	RandomService_Next_FullMethodName                 = "/random.RandomService/Next"
+	RandomService_CloseGenerator_FullMethodName       = "/random.RandomService/CloseGenerator"
+	RandomService_DrawSession_FullMethodName          = "/random.RandomService/DrawSession"
+	RandomService_CreateCommitment_FullMethodName     = "/random.RandomService/CreateCommitment"
+	RandomService_Draw_FullMethodName                 = "/random.RandomService/Draw"
+	RandomService_GetPublicKey_FullMethodName         = "/random.RandomService/GetPublicKey"
+	RandomService_SubmitJob_FullMethodName            = "/random.RandomService/SubmitJob"
+	RandomService_GetJob_FullMethodName               = "/random.RandomService/GetJob"
+	RandomService_CancelJob_FullMethodName            = "/random.RandomService/CancelJob"
+	RandomService_ListJobs_FullMethodName             = "/random.RandomService/ListJobs"
+	RandomService_ListDraws_FullMethodName            = "/random.RandomService/ListDraws"
+	RandomService_ExportDraws_FullMethodName          = "/random.RandomService/ExportDraws"
+	RandomService_GetUsage_FullMethodName             = "/random.RandomService/GetUsage"
 )
 
 // RandomServiceClient is the client API for RandomService service.
@@ -29,6 +56,57 @@ const (
 // RandomService is an interface exported by the server.
 type RandomServiceClient interface {
 	GetRandNumber(ctx context.Context, in *GetRandNumberRequest, opts ...grpc.CallOption) (*GetRandNumberReply, error)
+	StreamRandNumbers(ctx context.Context, in *StreamRandNumbersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamRandNumbersReply], error)
+	GetRandNumbers(ctx context.Context, in *GetRandNumbersRequest, opts ...grpc.CallOption) (*GetRandNumbersReply, error)
+	GetRandNumberInRange(ctx context.Context, in *GetRandNumberInRangeRequest, opts ...grpc.CallOption) (*GetRandNumberInRangeReply, error)
+	WeightedChoice(ctx context.Context, in *WeightedChoiceRequest, opts ...grpc.CallOption) (*WeightedChoiceReply, error)
+	Roll(ctx context.Context, in *RollRequest, opts ...grpc.CallOption) (*RollReply, error)
+	SampleDistribution(ctx context.Context, in *SampleDistributionRequest, opts ...grpc.CallOption) (*SampleDistributionReply, error)
+	GetRandBytes(ctx context.Context, in *GetRandBytesRequest, opts ...grpc.CallOption) (*GetRandBytesReply, error)
+	GetUUIDs(ctx context.Context, in *GetUUIDsRequest, opts ...grpc.CallOption) (*GetUUIDsReply, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenReply, error)
+	GetRandStrings(ctx context.Context, in *GetRandStringsRequest, opts ...grpc.CallOption) (*GetRandStringsReply, error)
+	// GenerateRecords streams reproducible fake records of a schema, record i
+	// is the same whatever the count.
+	GenerateRecords(ctx context.Context, in *GenerateRecordsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateRecordsReply], error)
+	Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffleReply, error)
+	GetRandNumberAt(ctx context.Context, in *GetRandNumberAtRequest, opts ...grpc.CallOption) (*GetRandNumberAtReply, error)
+	// CreateGenerator starts a generator kept on the server, Next continues its
+	// sequence across calls until CloseGenerator or the idle timeout frees it.
+	CreateGenerator(ctx context.Context, in *CreateGeneratorRequest, opts ...grpc.CallOption) (*CreateGeneratorReply, error)
+	Next(ctx context.Context, in *NextRequest, opts ...grpc.CallOption) (*NextReply, error)
+	CloseGenerator(ctx context.Context, in *CloseGeneratorRequest, opts ...grpc.CallOption) (*CloseGeneratorReply, error)
+	// DrawSession answers each command with a result drawn from a generator
+	// owned by the stream. The first command must be a reseed, and invalid
+	// commands end the stream. It has no HTTP route, the gateway cannot relay
+	// bidirectional streams.
+	DrawSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DrawCommand, DrawResult], error)
+	// CreateCommitment publishes the hash of a server secret, Draw later
+	// combines the secret with a client seed and reveals it so that the
+	// client can verify the result.
+	CreateCommitment(ctx context.Context, in *CreateCommitmentRequest, opts ...grpc.CallOption) (*CreateCommitmentReply, error)
+	Draw(ctx context.Context, in *DrawRequest, opts ...grpc.CallOption) (*DrawReply, error)
+	// GetPublicKey returns the Ed25519 public key that verifies signed outputs.
+	// Only the replies of GetRandNumber and GetRandNumberInRange are signed.
+	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyReply, error)
+	// SubmitJob queues a Monte Carlo estimation and returns without waiting
+	// for it. GetJob reports its progress and result, CancelJob stops it.
+	// Finished jobs are dropped after the server's retention period.
+	SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobReply, error)
+	GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobReply, error)
+	CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobReply, error)
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error)
+	// ListDraws returns the draws the server issued, oldest first, when it
+	// keeps a draw journal. Pass NextPageToken back as PageToken for the
+	// next page.
+	ListDraws(ctx context.Context, in *ListDrawsRequest, opts ...grpc.CallOption) (*ListDrawsReply, error)
+	// ExportDraws streams the draws of a time window as a CSV or NDJSON file,
+	// in chunks to be concatenated. It has no gateway route, which would wrap
+	// every chunk in JSON, the client serves the file at /v1/draws/export.
+	ExportDraws(ctx context.Context, in *ExportDrawsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDrawsReply], error)
+	// GetUsage returns the draws the caller made in the current quota windows.
+	// Callers are identified by the x-api-key metadata, or by their IP.
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error)
 }
 
 type randomServiceClient struct {
@@ -49,6 +127,306 @@ func (c *randomServiceClient) GetRandNumber(ctx context.Context, in *GetRandNumb
 	return out, nil
 }
 
+func (c *randomServiceClient) StreamRandNumbers(ctx context.Context, in *StreamRandNumbersRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StreamRandNumbersReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RandomService_ServiceDesc.Streams[0], RandomService_StreamRandNumbers_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamRandNumbersRequest, StreamRandNumbersReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_StreamRandNumbersClient = grpc.ServerStreamingClient[StreamRandNumbersReply]
+
+func (c *randomServiceClient) GetRandNumbers(ctx context.Context, in *GetRandNumbersRequest, opts ...grpc.CallOption) (*GetRandNumbersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRandNumbersReply)
+	err := c.cc.Invoke(ctx, RandomService_GetRandNumbers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetRandNumberInRange(ctx context.Context, in *GetRandNumberInRangeRequest, opts ...grpc.CallOption) (*GetRandNumberInRangeReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRandNumberInRangeReply)
+	err := c.cc.Invoke(ctx, RandomService_GetRandNumberInRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) WeightedChoice(ctx context.Context, in *WeightedChoiceRequest, opts ...grpc.CallOption) (*WeightedChoiceReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WeightedChoiceReply)
+	err := c.cc.Invoke(ctx, RandomService_WeightedChoice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) Roll(ctx context.Context, in *RollRequest, opts ...grpc.CallOption) (*RollReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RollReply)
+	err := c.cc.Invoke(ctx, RandomService_Roll_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) SampleDistribution(ctx context.Context, in *SampleDistributionRequest, opts ...grpc.CallOption) (*SampleDistributionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SampleDistributionReply)
+	err := c.cc.Invoke(ctx, RandomService_SampleDistribution_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetRandBytes(ctx context.Context, in *GetRandBytesRequest, opts ...grpc.CallOption) (*GetRandBytesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRandBytesReply)
+	err := c.cc.Invoke(ctx, RandomService_GetRandBytes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetUUIDs(ctx context.Context, in *GetUUIDsRequest, opts ...grpc.CallOption) (*GetUUIDsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUUIDsReply)
+	err := c.cc.Invoke(ctx, RandomService_GetUUIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTokenReply)
+	err := c.cc.Invoke(ctx, RandomService_GetToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetRandStrings(ctx context.Context, in *GetRandStringsRequest, opts ...grpc.CallOption) (*GetRandStringsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRandStringsReply)
+	err := c.cc.Invoke(ctx, RandomService_GetRandStrings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GenerateRecords(ctx context.Context, in *GenerateRecordsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GenerateRecordsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RandomService_ServiceDesc.Streams[1], RandomService_GenerateRecords_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GenerateRecordsRequest, GenerateRecordsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_GenerateRecordsClient = grpc.ServerStreamingClient[GenerateRecordsReply]
+
+func (c *randomServiceClient) Shuffle(ctx context.Context, in *ShuffleRequest, opts ...grpc.CallOption) (*ShuffleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShuffleReply)
+	err := c.cc.Invoke(ctx, RandomService_Shuffle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetRandNumberAt(ctx context.Context, in *GetRandNumberAtRequest, opts ...grpc.CallOption) (*GetRandNumberAtReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRandNumberAtReply)
+	err := c.cc.Invoke(ctx, RandomService_GetRandNumberAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) CreateGenerator(ctx context.Context, in *CreateGeneratorRequest, opts ...grpc.CallOption) (*CreateGeneratorReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGeneratorReply)
+	err := c.cc.Invoke(ctx, RandomService_CreateGenerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) Next(ctx context.Context, in *NextRequest, opts ...grpc.CallOption) (*NextReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NextReply)
+	err := c.cc.Invoke(ctx, RandomService_Next_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) CloseGenerator(ctx context.Context, in *CloseGeneratorRequest, opts ...grpc.CallOption) (*CloseGeneratorReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseGeneratorReply)
+	err := c.cc.Invoke(ctx, RandomService_CloseGenerator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) DrawSession(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DrawCommand, DrawResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RandomService_ServiceDesc.Streams[2], RandomService_DrawSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DrawCommand, DrawResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_DrawSessionClient = grpc.BidiStreamingClient[DrawCommand, DrawResult]
+
+func (c *randomServiceClient) CreateCommitment(ctx context.Context, in *CreateCommitmentRequest, opts ...grpc.CallOption) (*CreateCommitmentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCommitmentReply)
+	err := c.cc.Invoke(ctx, RandomService_CreateCommitment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) Draw(ctx context.Context, in *DrawRequest, opts ...grpc.CallOption) (*DrawReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DrawReply)
+	err := c.cc.Invoke(ctx, RandomService_Draw_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicKeyReply)
+	err := c.cc.Invoke(ctx, RandomService_GetPublicKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) SubmitJob(ctx context.Context, in *SubmitJobRequest, opts ...grpc.CallOption) (*SubmitJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitJobReply)
+	err := c.cc.Invoke(ctx, RandomService_SubmitJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) GetJob(ctx context.Context, in *GetJobRequest, opts ...grpc.CallOption) (*GetJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJobReply)
+	err := c.cc.Invoke(ctx, RandomService_GetJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) CancelJob(ctx context.Context, in *CancelJobRequest, opts ...grpc.CallOption) (*CancelJobReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelJobReply)
+	err := c.cc.Invoke(ctx, RandomService_CancelJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsReply)
+	err := c.cc.Invoke(ctx, RandomService_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) ListDraws(ctx context.Context, in *ListDrawsRequest, opts ...grpc.CallOption) (*ListDrawsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDrawsReply)
+	err := c.cc.Invoke(ctx, RandomService_ListDraws_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *randomServiceClient) ExportDraws(ctx context.Context, in *ExportDrawsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportDrawsReply], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RandomService_ServiceDesc.Streams[3], RandomService_ExportDraws_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDrawsRequest, ExportDrawsReply]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_ExportDrawsClient = grpc.ServerStreamingClient[ExportDrawsReply]
+
+func (c *randomServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageReply)
+	err := c.cc.Invoke(ctx, RandomService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RandomServiceServer is the server API for RandomService service.
 // All implementations must embed UnimplementedRandomServiceServer
 // for forward compatibility.
@@ -56,6 +434,57 @@ func (c *randomServiceClient) GetRandNumber(ctx context.Context, in *GetRandNumb
 // RandomService is an interface exported by the server.
 type RandomServiceServer interface {
 	GetRandNumber(context.Context, *GetRandNumberRequest) (*GetRandNumberReply, error)
+	StreamRandNumbers(*StreamRandNumbersRequest, grpc.ServerStreamingServer[StreamRandNumbersReply]) error
+	GetRandNumbers(context.Context, *GetRandNumbersRequest) (*GetRandNumbersReply, error)
+	GetRandNumberInRange(context.Context, *GetRandNumberInRangeRequest) (*GetRandNumberInRangeReply, error)
+	WeightedChoice(context.Context, *WeightedChoiceRequest) (*WeightedChoiceReply, error)
+	Roll(context.Context, *RollRequest) (*RollReply, error)
+	SampleDistribution(context.Context, *SampleDistributionRequest) (*SampleDistributionReply, error)
+	GetRandBytes(context.Context, *GetRandBytesRequest) (*GetRandBytesReply, error)
+	GetUUIDs(context.Context, *GetUUIDsRequest) (*GetUUIDsReply, error)
+	GetToken(context.Context, *GetTokenRequest) (*GetTokenReply, error)
+	GetRandStrings(context.Context, *GetRandStringsRequest) (*GetRandStringsReply, error)
+	// GenerateRecords streams reproducible fake records of a schema, record i
+	// is the same whatever the count.
+	GenerateRecords(*GenerateRecordsRequest, grpc.ServerStreamingServer[GenerateRecordsReply]) error
+	Shuffle(context.Context, *ShuffleRequest) (*ShuffleReply, error)
+	GetRandNumberAt(context.Context, *GetRandNumberAtRequest) (*GetRandNumberAtReply, error)
+	// CreateGenerator starts a generator kept on the server, Next continues its
+	// sequence across calls until CloseGenerator or the idle timeout frees it.
+	CreateGenerator(context.Context, *CreateGeneratorRequest) (*CreateGeneratorReply, error)
+	Next(context.Context, *NextRequest) (*NextReply, error)
+	CloseGenerator(context.Context, *CloseGeneratorRequest) (*CloseGeneratorReply, error)
+	// DrawSession answers each command with a result drawn from a generator
+	// owned by the stream. The first command must be a reseed, and invalid
+	// commands end the stream. It has no HTTP route, the gateway cannot relay
+	// bidirectional streams.
+	DrawSession(grpc.BidiStreamingServer[DrawCommand, DrawResult]) error
+	// CreateCommitment publishes the hash of a server secret, Draw later
+	// combines the secret with a client seed and reveals it so that the
+	// client can verify the result.
+	CreateCommitment(context.Context, *CreateCommitmentRequest) (*CreateCommitmentReply, error)
+	Draw(context.Context, *DrawRequest) (*DrawReply, error)
+	// GetPublicKey returns the Ed25519 public key that verifies signed outputs.
+	// Only the replies of GetRandNumber and GetRandNumberInRange are signed.
+	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyReply, error)
+	// SubmitJob queues a Monte Carlo estimation and returns without waiting
+	// for it. GetJob reports its progress and result, CancelJob stops it.
+	// Finished jobs are dropped after the server's retention period.
+	SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobReply, error)
+	GetJob(context.Context, *GetJobRequest) (*GetJobReply, error)
+	CancelJob(context.Context, *CancelJobRequest) (*CancelJobReply, error)
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error)
+	// ListDraws returns the draws the server issued, oldest first, when it
+	// keeps a draw journal. Pass NextPageToken back as PageToken for the
+	// next page.
+	ListDraws(context.Context, *ListDrawsRequest) (*ListDrawsReply, error)
+	// ExportDraws streams the draws of a time window as a CSV or NDJSON file,
+	// in chunks to be concatenated. It has no gateway route, which would wrap
+	// every chunk in JSON, the client serves the file at /v1/draws/export.
+	ExportDraws(*ExportDrawsRequest, grpc.ServerStreamingServer[ExportDrawsReply]) error
+	// GetUsage returns the draws the caller made in the current quota windows.
+	// Callers are identified by the x-api-key metadata, or by their IP.
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error)
 	mustEmbedUnimplementedRandomServiceServer()
 }
 
@@ -69,6 +498,87 @@ type UnimplementedRandomServiceServer struct{}
 func (UnimplementedRandomServiceServer) GetRandNumber(context.Context, *GetRandNumberRequest) (*GetRandNumberReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRandNumber not implemented")
 }
+func (UnimplementedRandomServiceServer) StreamRandNumbers(*StreamRandNumbersRequest, grpc.ServerStreamingServer[StreamRandNumbersReply]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamRandNumbers not implemented")
+}
+func (UnimplementedRandomServiceServer) GetRandNumbers(context.Context, *GetRandNumbersRequest) (*GetRandNumbersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandNumbers not implemented")
+}
+func (UnimplementedRandomServiceServer) GetRandNumberInRange(context.Context, *GetRandNumberInRangeRequest) (*GetRandNumberInRangeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandNumberInRange not implemented")
+}
+func (UnimplementedRandomServiceServer) WeightedChoice(context.Context, *WeightedChoiceRequest) (*WeightedChoiceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WeightedChoice not implemented")
+}
+func (UnimplementedRandomServiceServer) Roll(context.Context, *RollRequest) (*RollReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Roll not implemented")
+}
+func (UnimplementedRandomServiceServer) SampleDistribution(context.Context, *SampleDistributionRequest) (*SampleDistributionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleDistribution not implemented")
+}
+func (UnimplementedRandomServiceServer) GetRandBytes(context.Context, *GetRandBytesRequest) (*GetRandBytesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandBytes not implemented")
+}
+func (UnimplementedRandomServiceServer) GetUUIDs(context.Context, *GetUUIDsRequest) (*GetUUIDsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUUIDs not implemented")
+}
+func (UnimplementedRandomServiceServer) GetToken(context.Context, *GetTokenRequest) (*GetTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedRandomServiceServer) GetRandStrings(context.Context, *GetRandStringsRequest) (*GetRandStringsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandStrings not implemented")
+}
+func (UnimplementedRandomServiceServer) GenerateRecords(*GenerateRecordsRequest, grpc.ServerStreamingServer[GenerateRecordsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateRecords not implemented")
+}
+func (UnimplementedRandomServiceServer) Shuffle(context.Context, *ShuffleRequest) (*ShuffleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shuffle not implemented")
+}
+func (UnimplementedRandomServiceServer) GetRandNumberAt(context.Context, *GetRandNumberAtRequest) (*GetRandNumberAtReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRandNumberAt not implemented")
+}
+func (UnimplementedRandomServiceServer) CreateGenerator(context.Context, *CreateGeneratorRequest) (*CreateGeneratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGenerator not implemented")
+}
+func (UnimplementedRandomServiceServer) Next(context.Context, *NextRequest) (*NextReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Next not implemented")
+}
+func (UnimplementedRandomServiceServer) CloseGenerator(context.Context, *CloseGeneratorRequest) (*CloseGeneratorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseGenerator not implemented")
+}
+func (UnimplementedRandomServiceServer) DrawSession(grpc.BidiStreamingServer[DrawCommand, DrawResult]) error {
+	return status.Errorf(codes.Unimplemented, "method DrawSession not implemented")
+}
+func (UnimplementedRandomServiceServer) CreateCommitment(context.Context, *CreateCommitmentRequest) (*CreateCommitmentReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCommitment not implemented")
+}
+func (UnimplementedRandomServiceServer) Draw(context.Context, *DrawRequest) (*DrawReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Draw not implemented")
+}
+func (UnimplementedRandomServiceServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
+}
+func (UnimplementedRandomServiceServer) SubmitJob(context.Context, *SubmitJobRequest) (*SubmitJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitJob not implemented")
+}
+func (UnimplementedRandomServiceServer) GetJob(context.Context, *GetJobRequest) (*GetJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJob not implemented")
+}
+func (UnimplementedRandomServiceServer) CancelJob(context.Context, *CancelJobRequest) (*CancelJobReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
+}
+func (UnimplementedRandomServiceServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedRandomServiceServer) ListDraws(context.Context, *ListDrawsRequest) (*ListDrawsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDraws not implemented")
+}
+func (UnimplementedRandomServiceServer) ExportDraws(*ExportDrawsRequest, grpc.ServerStreamingServer[ExportDrawsReply]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDraws not implemented")
+}
+func (UnimplementedRandomServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
 func (UnimplementedRandomServiceServer) mustEmbedUnimplementedRandomServiceServer() {}
 func (UnimplementedRandomServiceServer) testEmbeddedByValue()                       {}
 
@@ -108,18 +618,586 @@ func _RandomService_GetRandNumber_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
-// RandomService_ServiceDesc is the grpc.ServiceDesc for RandomService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RandomService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "random.RandomService",
-	HandlerType: (*RandomServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetRandNumber",
-			Handler:    _RandomService_GetRandNumber_Handler,
+func _RandomService_StreamRandNumbers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamRandNumbersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RandomServiceServer).StreamRandNumbers(m, &grpc.GenericServerStream[StreamRandNumbersRequest, StreamRandNumbersReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_StreamRandNumbersServer = grpc.ServerStreamingServer[StreamRandNumbersReply]
+
+func _RandomService_GetRandNumbers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandNumbersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetRandNumbers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetRandNumbers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetRandNumbers(ctx, req.(*GetRandNumbersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetRandNumberInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandNumberInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetRandNumberInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetRandNumberInRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetRandNumberInRange(ctx, req.(*GetRandNumberInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_WeightedChoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WeightedChoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).WeightedChoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_WeightedChoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).WeightedChoice(ctx, req.(*WeightedChoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_Roll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).Roll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_Roll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).Roll(ctx, req.(*RollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_SampleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).SampleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_SampleDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).SampleDistribution(ctx, req.(*SampleDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetRandBytes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandBytesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetRandBytes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetRandBytes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetRandBytes(ctx, req.(*GetRandBytesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetUUIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUUIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetUUIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetUUIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetUUIDs(ctx, req.(*GetUUIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetRandStrings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandStringsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetRandStrings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetRandStrings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetRandStrings(ctx, req.(*GetRandStringsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GenerateRecords_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GenerateRecordsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RandomServiceServer).GenerateRecords(m, &grpc.GenericServerStream[GenerateRecordsRequest, GenerateRecordsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_GenerateRecordsServer = grpc.ServerStreamingServer[GenerateRecordsReply]
+
+func _RandomService_Shuffle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShuffleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).Shuffle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_Shuffle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).Shuffle(ctx, req.(*ShuffleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetRandNumberAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRandNumberAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetRandNumberAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetRandNumberAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetRandNumberAt(ctx, req.(*GetRandNumberAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_CreateGenerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGeneratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).CreateGenerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_CreateGenerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).CreateGenerator(ctx, req.(*CreateGeneratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_Next_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NextRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).Next(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_Next_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).Next(ctx, req.(*NextRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_CloseGenerator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseGeneratorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).CloseGenerator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_CloseGenerator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).CloseGenerator(ctx, req.(*CloseGeneratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_DrawSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RandomServiceServer).DrawSession(&grpc.GenericServerStream[DrawCommand, DrawResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_DrawSessionServer = grpc.BidiStreamingServer[DrawCommand, DrawResult]
+
+func _RandomService_CreateCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).CreateCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_CreateCommitment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).CreateCommitment(ctx, req.(*CreateCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_Draw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrawRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).Draw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_Draw_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).Draw(ctx, req.(*DrawRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetPublicKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetPublicKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetPublicKey(ctx, req.(*GetPublicKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_SubmitJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).SubmitJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_SubmitJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).SubmitJob(ctx, req.(*SubmitJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_GetJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetJob(ctx, req.(*GetJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_CancelJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).CancelJob(ctx, req.(*CancelJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_ListDraws_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDrawsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).ListDraws(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_ListDraws_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).ListDraws(ctx, req.(*ListDrawsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RandomService_ExportDraws_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDrawsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RandomServiceServer).ExportDraws(m, &grpc.GenericServerStream[ExportDrawsRequest, ExportDrawsReply]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RandomService_ExportDrawsServer = grpc.ServerStreamingServer[ExportDrawsReply]
+
+func _RandomService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RandomServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RandomService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RandomServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RandomService_ServiceDesc is the grpc.ServiceDesc for RandomService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RandomService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "random.RandomService",
+	HandlerType: (*RandomServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRandNumber",
+			Handler:    _RandomService_GetRandNumber_Handler,
+		},
+		{
+			MethodName: "GetRandNumbers",
+			Handler:    _RandomService_GetRandNumbers_Handler,
+		},
+		{
+			MethodName: "GetRandNumberInRange",
+			Handler:    _RandomService_GetRandNumberInRange_Handler,
+		},
+		{
+			MethodName: "WeightedChoice",
+			Handler:    _RandomService_WeightedChoice_Handler,
+		},
+		{
+			MethodName: "Roll",
+			Handler:    _RandomService_Roll_Handler,
+		},
+		{
+			MethodName: "SampleDistribution",
+			Handler:    _RandomService_SampleDistribution_Handler,
+		},
+		{
+			MethodName: "GetRandBytes",
+			Handler:    _RandomService_GetRandBytes_Handler,
+		},
+		{
+			MethodName: "GetUUIDs",
+			Handler:    _RandomService_GetUUIDs_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _RandomService_GetToken_Handler,
+		},
+		{
+			MethodName: "GetRandStrings",
+			Handler:    _RandomService_GetRandStrings_Handler,
+		},
+		{
+			MethodName: "Shuffle",
+			Handler:    _RandomService_Shuffle_Handler,
+		},
+		{
+			MethodName: "GetRandNumberAt",
+			Handler:    _RandomService_GetRandNumberAt_Handler,
+		},
+		{
+			MethodName: "CreateGenerator",
+			Handler:    _RandomService_CreateGenerator_Handler,
+		},
+		{
+			MethodName: "Next",
+			Handler:    _RandomService_Next_Handler,
+		},
+		{
+			MethodName: "CloseGenerator",
+			Handler:    _RandomService_CloseGenerator_Handler,
+		},
+		{
+			MethodName: "CreateCommitment",
+			Handler:    _RandomService_CreateCommitment_Handler,
+		},
+		{
+			MethodName: "Draw",
+			Handler:    _RandomService_Draw_Handler,
+		},
+		{
+			MethodName: "GetPublicKey",
+			Handler:    _RandomService_GetPublicKey_Handler,
+		},
+		{
+			MethodName: "SubmitJob",
+			Handler:    _RandomService_SubmitJob_Handler,
+		},
+		{
+			MethodName: "GetJob",
+			Handler:    _RandomService_GetJob_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _RandomService_CancelJob_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _RandomService_ListJobs_Handler,
+		},
+		{
+			MethodName: "ListDraws",
+			Handler:    _RandomService_ListDraws_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _RandomService_GetUsage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamRandNumbers",
+			Handler:       _RandomService_StreamRandNumbers_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GenerateRecords",
+			Handler:       _RandomService_GenerateRecords_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DrawSession",
+			Handler:       _RandomService_DrawSession_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportDraws",
+			Handler:       _RandomService_ExportDraws_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/pb/random/random.proto",
 }
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/journal"
+)
+
+// Checks the hash chain of the draw journal, given as argument or configured
+// under journal.path, and exits with status 1 if it was tampered with.
+func main() {
+	path, err := journalPath()
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(2)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(2)
+	}
+	defer file.Close()
+
+	summary, err := journal.Verify(file)
+	if err != nil {
+		fmt.Printf("%s is broken after %d valid entries: %v\n", path, summary.Entries, err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s is intact: %d entries, last hash %s\n", path, summary.Entries, summary.LastHash)
+}
+
+func journalPath() (string, error) {
+	if len(os.Args) > 1 {
+		return os.Args[1], nil
+	}
+
+	// Read config
+	v, err := config.LoadConfig("config/config.yaml")
+	if err != nil {
+		return "", err
+	}
+	config, err := config.ParseConfig(v)
+	if err != nil {
+		return "", err
+	}
+
+	return config.Journal.Path, nil
+}
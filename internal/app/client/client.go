@@ -2,8 +2,20 @@ package client
 
 import (
 	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/fair"
+	"github.com/minhthong582000/soa-404/pkg/signing"
 )
 
 // Client is a simple client for the Random service.
@@ -18,10 +30,24 @@ func NewClient(randClient pb.RandomServiceClient) *Client {
 	}
 }
 
-// GetRandNumber gets a random number from the server.
-func (c Client) GetRandNumber(ctx context.Context, seed int64) (int64, error) {
+// GetRandNumber gets a random number from the server. Every seeded method
+// takes the name of the generator algorithm, empty selects the server's default.
+func (c Client) GetRandNumber(ctx context.Context, seed int64, algorithm string) (int64, error) {
 	reply, err := c.randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{
-		SeedNum: seed,
+		SeedNum:   seed,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return reply.Number, nil
+}
+
+// GetSecureRandNumber gets a random number drawn from a cryptographically secure source.
+func (c Client) GetSecureRandNumber(ctx context.Context) (int64, error) {
+	reply, err := c.randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{
+		Mode: pb.Mode_MODE_SECURE,
 	})
 	if err != nil {
 		return -1, err
@@ -29,3 +55,680 @@ func (c Client) GetRandNumber(ctx context.Context, seed int64) (int64, error) {
 
 	return reply.Number, nil
 }
+
+// StreamRandNumbers streams count random numbers generated from seed to fn.
+// A count of 0 streams until ctx is cancelled or fn returns an error.
+func (c Client) StreamRandNumbers(ctx context.Context, seed int64, algorithm string, count int64, fn func(index int64, number int64) error) error {
+	stream, err := c.randClient.StreamRandNumbers(ctx, &pb.StreamRandNumbersRequest{
+		SeedNum:   seed,
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(reply.Index, reply.Number); err != nil {
+			return err
+		}
+	}
+}
+
+// GenerateRecords streams count fake records of fields generated from seed to
+// fn, as maps from field names to values. Int fields are int64, the others strings.
+func (c Client) GenerateRecords(ctx context.Context, seed int64, algorithm string, fields []*pb.FieldSpec, count int64, fn func(index int64, record map[string]any) error) error {
+	stream, err := c.randClient.GenerateRecords(ctx, &pb.GenerateRecordsRequest{
+		SeedNum:   seed,
+		Fields:    fields,
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		record := make(map[string]any, len(reply.Fields))
+		for _, field := range reply.Fields {
+			switch v := field.Value.(type) {
+			case *pb.FieldValue_Int:
+				record[field.Name] = v.Int
+			case *pb.FieldValue_String_:
+				record[field.Name] = v.String_
+			}
+		}
+		if err := fn(reply.Index, record); err != nil {
+			return err
+		}
+	}
+}
+
+// GetRandNumbers gets one random number per seed from the server in a single call.
+func (c Client) GetRandNumbers(ctx context.Context, seeds []int64, algorithm string) ([]*pb.RandNumberResult, error) {
+	reply, err := c.randClient.GetRandNumbers(ctx, &pb.GetRandNumbersRequest{
+		SeedNums:  seeds,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Results, nil
+}
+
+// WeightedChoice gets count picks of items from the server, each item picked
+// with a probability proportional to the weight at the same position.
+func (c Client) WeightedChoice(ctx context.Context, seed int64, algorithm string, items []string, weights []float64, count int64) ([]string, error) {
+	if len(items) != len(weights) {
+		return nil, fmt.Errorf("got %d items for %d weights", len(items), len(weights))
+	}
+
+	request := &pb.WeightedChoiceRequest{
+		SeedNum:   seed,
+		Items:     make([]*pb.WeightedItem, 0, len(items)),
+		Count:     count,
+		Algorithm: algorithm,
+	}
+	for i, item := range items {
+		request.Items = append(request.Items, &pb.WeightedItem{Value: item, Weight: weights[i]})
+	}
+
+	reply, err := c.randClient.WeightedChoice(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Items, nil
+}
+
+// Roll rolls dice notation such as "4d6kh3+2" on the server.
+func (c Client) Roll(ctx context.Context, seed int64, algorithm string, notation string) (*entity.Roll, error) {
+	reply, err := c.randClient.Roll(ctx, &pb.RollRequest{
+		SeedNum:   seed,
+		Dice:      notation,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	roll := &entity.Roll{
+		Total:    reply.Total,
+		Modifier: reply.Modifier,
+		Dice:     make([]entity.DieRoll, 0, len(reply.Dice)),
+	}
+	for _, die := range reply.Dice {
+		roll.Dice = append(roll.Dice, entity.DieRoll{
+			Term:     int(die.Term),
+			Sides:    die.Sides,
+			Value:    die.Value,
+			Kept:     die.Kept,
+			Exploded: die.Exploded,
+		})
+	}
+
+	return roll, nil
+}
+
+// GetRandNumberInIntRange gets a random number in [min, max] from the server.
+func (c Client) GetRandNumberInIntRange(ctx context.Context, seed int64, algorithm string, min int64, max int64) (int64, error) {
+	reply, err := c.randClient.GetRandNumberInRange(ctx, &pb.GetRandNumberInRangeRequest{
+		SeedNum: seed,
+		Range: &pb.GetRandNumberInRangeRequest_IntRange{
+			IntRange: &pb.IntRange{Min: min, Max: max},
+		},
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return reply.GetNumber(), nil
+}
+
+// GetRandNumberInFloatRange gets a random number in [min, max) from the server.
+func (c Client) GetRandNumberInFloatRange(ctx context.Context, seed int64, algorithm string, min float64, max float64) (float64, error) {
+	reply, err := c.randClient.GetRandNumberInRange(ctx, &pb.GetRandNumberInRangeRequest{
+		SeedNum: seed,
+		Range: &pb.GetRandNumberInRangeRequest_FloatRange{
+			FloatRange: &pb.FloatRange{Min: min, Max: max},
+		},
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return -1, err
+	}
+
+	return reply.GetFloat(), nil
+}
+
+// SampleDistribution gets count samples of dist from the server.
+func (c Client) SampleDistribution(ctx context.Context, seed int64, algorithm string, count int64, dist entity.Distribution) ([]float64, error) {
+	request := &pb.SampleDistributionRequest{
+		SeedNum:   seed,
+		Count:     count,
+		Algorithm: algorithm,
+	}
+	switch dist.Kind {
+	case entity.Normal:
+		request.Distribution = &pb.SampleDistributionRequest_Normal{
+			Normal: &pb.NormalDistribution{Mean: dist.Mean, StdDev: dist.StdDev},
+		}
+	case entity.Exponential:
+		request.Distribution = &pb.SampleDistributionRequest_Exponential{
+			Exponential: &pb.ExponentialDistribution{Rate: dist.Rate},
+		}
+	case entity.Poisson:
+		request.Distribution = &pb.SampleDistributionRequest_Poisson{
+			Poisson: &pb.PoissonDistribution{Lambda: dist.Lambda},
+		}
+	case entity.Binomial:
+		request.Distribution = &pb.SampleDistributionRequest_Binomial{
+			Binomial: &pb.BinomialDistribution{N: dist.N, P: dist.P},
+		}
+	case entity.Uniform:
+		request.Distribution = &pb.SampleDistributionRequest_Uniform{
+			Uniform: &pb.FloatRange{Min: dist.Min, Max: dist.Max},
+		}
+	default:
+		return nil, fmt.Errorf("unknown distribution %q", dist.Kind)
+	}
+
+	reply, err := c.randClient.SampleDistribution(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Samples, nil
+}
+
+// GetRandBytes gets length random bytes from the server, encoded as hex or base64.
+func (c Client) GetRandBytes(ctx context.Context, seed int64, mode pb.Mode, algorithm string, length int32, encoding pb.Encoding) (string, error) {
+	reply, err := c.randClient.GetRandBytes(ctx, &pb.GetRandBytesRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Length:    length,
+		Encoding:  encoding,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return reply.Data, nil
+}
+
+// GetUUIDs gets count UUIDs of the given version from the server.
+func (c Client) GetUUIDs(ctx context.Context, seed int64, mode pb.Mode, algorithm string, version pb.UUIDVersion, count int32) ([]string, error) {
+	reply, err := c.randClient.GetUUIDs(ctx, &pb.GetUUIDsRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Version:   version,
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.UUIDs, nil
+}
+
+// GetToken gets a URL-safe token of length characters from the server.
+func (c Client) GetToken(ctx context.Context, seed int64, mode pb.Mode, algorithm string, length int32) (string, error) {
+	reply, err := c.randClient.GetToken(ctx, &pb.GetTokenRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Length:    length,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return reply.Token, nil
+}
+
+// GetPatternStrings gets count strings matching pattern, e.g. "[A-Z]{3}-[0-9]{4}".
+func (c Client) GetPatternStrings(ctx context.Context, seed int64, mode pb.Mode, algorithm string, pattern string, count int32) ([]string, error) {
+	reply, err := c.randClient.GetRandStrings(ctx, &pb.GetRandStringsRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Spec:      &pb.GetRandStringsRequest_Pattern{Pattern: pattern},
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Strings, nil
+}
+
+// GetCharsetStrings gets count strings of length characters from charset, e.g. "A-Z0-9".
+func (c Client) GetCharsetStrings(ctx context.Context, seed int64, mode pb.Mode, algorithm string, charset string, length int32, count int32) ([]string, error) {
+	reply, err := c.randClient.GetRandStrings(ctx, &pb.GetRandStringsRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Spec:      &pb.GetRandStringsRequest_Charset{Charset: charset},
+		Length:    length,
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Strings, nil
+}
+
+// Shuffle gets a seeded permutation of items from the server, or k of them
+// sampled without replacement when k is greater than 0.
+func (c Client) Shuffle(ctx context.Context, seed int64, algorithm string, items []string, k int64) ([]string, error) {
+	reply, err := c.randClient.Shuffle(ctx, &pb.ShuffleRequest{
+		SeedNum: seed,
+		Population: &pb.ShuffleRequest_Items{
+			Items: &pb.ShuffleItems{Values: items},
+		},
+		SampleSize: k,
+		Algorithm:  algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Items, nil
+}
+
+// GetRandNumbersAt gets count values of the sequence of seed starting at position index.
+// Workers can split one sequence between them by asking for disjoint ranges of positions.
+// The algorithm must be able to jump ahead, empty selects pcg.
+func (c Client) GetRandNumbersAt(ctx context.Context, seed int64, algorithm string, index int64, count int64) ([]int64, error) {
+	reply, err := c.randClient.GetRandNumberAt(ctx, &pb.GetRandNumberAtRequest{
+		SeedNum:   seed,
+		Index:     index,
+		Count:     count,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Numbers, nil
+}
+
+// CreateGenerator starts a generator on the server and returns its ID. The
+// generator is freed by CloseGenerator, or after being idle for too long.
+func (c Client) CreateGenerator(ctx context.Context, seed int64, algorithm string) (string, error) {
+	reply, err := c.randClient.CreateGenerator(ctx, &pb.CreateGeneratorRequest{
+		SeedNum:   seed,
+		Algorithm: algorithm,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return reply.GeneratorID, nil
+}
+
+// Next gets the next count values of the generator id.
+func (c Client) Next(ctx context.Context, id string, count int64) ([]int64, error) {
+	reply, err := c.randClient.Next(ctx, &pb.NextRequest{
+		GeneratorID: id,
+		Count:       count,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Numbers, nil
+}
+
+// CloseGenerator frees the generator id.
+func (c Client) CloseGenerator(ctx context.Context, id string) error {
+	_, err := c.randClient.CloseGenerator(ctx, &pb.CloseGeneratorRequest{
+		GeneratorID: id,
+	})
+
+	return err
+}
+
+// CreateCommitment gets the commitment to a server secret for a provably fair draw.
+// Its Hash must be published before the client seed is chosen.
+func (c Client) CreateCommitment(ctx context.Context) (*entity.Commitment, error) {
+	reply, err := c.randClient.CreateCommitment(ctx, &pb.CreateCommitmentRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Commitment{
+		ID:        reply.CommitmentID,
+		Hash:      reply.Commitment,
+		ExpiresAt: time.Unix(reply.ExpiresAt, 0),
+	}, nil
+}
+
+// Draw gets the number in [min, max] drawn from the commitment id and
+// clientSeed, along with the revealed secret.
+func (c Client) Draw(ctx context.Context, id string, clientSeed string, min, max int64) (*entity.FairDraw, error) {
+	reply, err := c.randClient.Draw(ctx, &pb.DrawRequest{
+		CommitmentID: id,
+		ClientSeed:   clientSeed,
+		Range:        &pb.IntRange{Min: min, Max: max},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := hex.DecodeString(reply.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret: %w", err)
+	}
+
+	return &entity.FairDraw{
+		Commitment: entity.Commitment{
+			ID:     id,
+			Hash:   reply.Commitment,
+			Secret: secret,
+		},
+		ClientSeed: reply.ClientSeed,
+		Range:      entity.IntRange{Min: reply.Range.GetMin(), Max: reply.Range.GetMax()},
+		Number:     reply.Number,
+	}, nil
+}
+
+// VerifyDraw checks draw against the commitment published before it. The
+// revealed secret must hash to commitment, and the number must be the one
+// drawn from the secret, the client seed and the range.
+func VerifyDraw(commitment string, draw *entity.FairDraw) error {
+	if fair.Commit(draw.Commitment.Secret) != commitment {
+		return errors.New("the revealed secret does not match the commitment")
+	}
+
+	if draw.Range.Min > draw.Range.Max {
+		return fmt.Errorf("invalid range [%d, %d]", draw.Range.Min, draw.Range.Max)
+	}
+	if fair.Number(draw.Commitment.Secret, draw.ClientSeed, draw.Range.Min, draw.Range.Max) != draw.Number {
+		return fmt.Errorf("number %d was not drawn from the secret and client seed %q", draw.Number, draw.ClientSeed)
+	}
+
+	return nil
+}
+
+// GetPublicKey gets the public key keyID, an empty keyID gets the key that
+// signs new outputs.
+func (c Client) GetPublicKey(ctx context.Context, keyID string) (*entity.PublicKey, error) {
+	reply, err := c.randClient.GetPublicKey(ctx, &pb.GetPublicKeyRequest{
+		KeyID: keyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.PublicKey{
+		KeyID:  reply.KeyID,
+		Key:    reply.PublicKey,
+		Active: reply.Active,
+	}, nil
+}
+
+// GetSignedRandNumber gets a random number along with its signature, which
+// VerifyRandNumber checks. The signature is nil when the server does not sign
+// its outputs.
+func (c Client) GetSignedRandNumber(ctx context.Context, seed int64, mode pb.Mode, algorithm string) (*pb.GetRandNumberReply, error) {
+	return c.randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{
+		SeedNum:   seed,
+		Mode:      mode,
+		Algorithm: algorithm,
+	})
+}
+
+// VerifyRandNumber checks that reply, returned for seed, was signed by
+// publicKey. publicKey must be the key reply.Signature.KeyID, see GetPublicKey.
+func VerifyRandNumber(publicKey ed25519.PublicKey, seed int64, reply *pb.GetRandNumberReply) error {
+	mode := entity.Seeded
+	if reply.Mode == pb.Mode_MODE_SECURE {
+		mode, seed = entity.Secure, 0
+	}
+
+	return verify(publicKey, reply.Signature, signing.Payload{
+		Seed:             seed,
+		Mode:             string(mode),
+		Algorithm:        reply.Algorithm,
+		AlgorithmVersion: reply.AlgorithmVersion,
+		Output:           strconv.FormatInt(reply.Number, 10),
+	})
+}
+
+// VerifyRandNumberInRange checks that reply, returned for request, was signed
+// by publicKey.
+func VerifyRandNumberInRange(publicKey ed25519.PublicKey, request *pb.GetRandNumberInRangeRequest, reply *pb.GetRandNumberInRangeReply) error {
+	payload := signing.Payload{
+		Seed:             request.SeedNum,
+		Mode:             string(entity.Seeded),
+		Algorithm:        reply.Algorithm,
+		AlgorithmVersion: reply.AlgorithmVersion,
+	}
+
+	switch r := request.Range.(type) {
+	case *pb.GetRandNumberInRangeRequest_IntRange:
+		payload.Range = signing.IntRange(r.IntRange.Min, r.IntRange.Max)
+	case *pb.GetRandNumberInRangeRequest_FloatRange:
+		payload.Range = signing.FloatRange(r.FloatRange.Min, r.FloatRange.Max)
+	default:
+		return errors.New("the request has no range")
+	}
+
+	switch v := reply.Value.(type) {
+	case *pb.GetRandNumberInRangeReply_Number:
+		payload.Output = strconv.FormatInt(v.Number, 10)
+	case *pb.GetRandNumberInRangeReply_Float:
+		payload.Output = signing.Float(v.Float)
+	default:
+		return errors.New("the reply has no value")
+	}
+
+	return verify(publicKey, reply.Signature, payload)
+}
+
+// VerifyReply checks that reply, a reply of any other draw RPC returned for
+// request, was signed by publicKey. The results of a DrawSession are checked
+// against the last reseed command.
+func VerifyReply(publicKey ed25519.PublicKey, request, reply proto.Message) error {
+	signed, ok := reply.(interface{ GetSignature() *pb.Signature })
+	if !ok {
+		return fmt.Errorf("%s replies are not signed", reply.ProtoReflect().Descriptor().Name())
+	}
+	output, err := signing.Message(reply)
+	if err != nil {
+		return err
+	}
+
+	// The server signs the seed and mode of requests with a single seed
+	payload := signing.Payload{Output: output}
+	if r, ok := request.(interface{ GetMode() pb.Mode }); ok && r.GetMode() == pb.Mode_MODE_SECURE {
+		payload.Mode = string(entity.Secure)
+	} else if r, ok := request.(interface{ GetSeedNum() int64 }); ok {
+		payload.Seed, payload.Mode = r.GetSeedNum(), string(entity.Seeded)
+	}
+	if r, ok := reply.(interface {
+		GetAlgorithm() string
+		GetAlgorithmVersion() string
+	}); ok {
+		payload.Algorithm, payload.AlgorithmVersion = r.GetAlgorithm(), r.GetAlgorithmVersion()
+	}
+
+	return verify(publicKey, signed.GetSignature(), payload)
+}
+
+func verify(publicKey ed25519.PublicKey, signature *pb.Signature, payload signing.Payload) error {
+	if signature == nil {
+		return errors.New("the reply is not signed")
+	}
+
+	payload.KeyID = signature.KeyID
+	payload.Timestamp = time.Unix(0, signature.Timestamp)
+	if !signing.Verify(publicKey, payload, signature.Value) {
+		return fmt.Errorf("invalid signature by key %q", signature.KeyID)
+	}
+
+	return nil
+}
+
+// DrawSession opens a stream of draw commands answered from a generator kept
+// for the stream, the first command must reseed it.
+func (c Client) DrawSession(ctx context.Context) (pb.RandomService_DrawSessionClient, error) {
+	return c.randClient.DrawSession(ctx)
+}
+
+// SubmitPiJob queues a job estimating pi from samples random points.
+func (c Client) SubmitPiJob(ctx context.Context, seed int64, algorithm string, samples int64) (*pb.Job, error) {
+	return c.submitJob(ctx, &pb.SubmitJobRequest{
+		SeedNum:   seed,
+		Algorithm: algorithm,
+		Samples:   samples,
+		Estimate:  &pb.SubmitJobRequest_Pi{Pi: &pb.PiEstimate{}},
+	})
+}
+
+// SubmitIntegralJob queues a job estimating the integral of expression, a
+// function of x, over [min, max] from samples random points.
+func (c Client) SubmitIntegralJob(ctx context.Context, seed int64, algorithm string, samples int64, expression string, min, max float64) (*pb.Job, error) {
+	return c.submitJob(ctx, &pb.SubmitJobRequest{
+		SeedNum:   seed,
+		Algorithm: algorithm,
+		Samples:   samples,
+		Estimate: &pb.SubmitJobRequest_Integral{Integral: &pb.IntegralEstimate{
+			Expression: expression,
+			Min:        min,
+			Max:        max,
+		}},
+	})
+}
+
+func (c Client) submitJob(ctx context.Context, request *pb.SubmitJobRequest) (*pb.Job, error) {
+	reply, err := c.randClient.SubmitJob(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Job, nil
+}
+
+// GetJob gets the progress of the job id, and its result once it succeeded.
+func (c Client) GetJob(ctx context.Context, id string) (*pb.Job, error) {
+	reply, err := c.randClient.GetJob(ctx, &pb.GetJobRequest{
+		JobID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Job, nil
+}
+
+// WaitJob polls the job id every interval until it finishes or ctx is done.
+func (c Client) WaitJob(ctx context.Context, id string, interval time.Duration) (*pb.Job, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.GetJob(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		switch job.State {
+		case pb.JobState_JOB_STATE_SUCCEEDED, pb.JobState_JOB_STATE_FAILED, pb.JobState_JOB_STATE_CANCELLED:
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// CancelJob stops the job id.
+func (c Client) CancelJob(ctx context.Context, id string) (*pb.Job, error) {
+	reply, err := c.randClient.CancelJob(ctx, &pb.CancelJobRequest{
+		JobID: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Job, nil
+}
+
+// ListJobs lists the jobs in state, newest first, JOB_STATE_UNSPECIFIED
+// lists every job.
+func (c Client) ListJobs(ctx context.Context, state pb.JobState) ([]*pb.Job, error) {
+	reply, err := c.randClient.ListJobs(ctx, &pb.ListJobsRequest{
+		State: state,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reply.Jobs, nil
+}
+
+// ListDraws lists a page of the draws the server issued that request
+// selects, oldest first.
+func (c Client) ListDraws(ctx context.Context, request *pb.ListDrawsRequest) (*pb.ListDrawsReply, error) {
+	return c.randClient.ListDraws(ctx, request)
+}
+
+// ExportDraws streams the draws issued between from and to, zero times
+// leaving the window open, as a file in format to fn, chunk by chunk.
+func (c Client) ExportDraws(ctx context.Context, from, to time.Time, format pb.ExportFormat, fn func(chunk []byte) error) error {
+	request := &pb.ExportDrawsRequest{Format: format}
+	if !from.IsZero() {
+		request.From = from.UnixNano()
+	}
+	if !to.IsZero() {
+		request.To = to.UnixNano()
+	}
+	stream, err := c.randClient.ExportDraws(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	for {
+		reply, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := fn(reply.Chunk); err != nil {
+			return err
+		}
+	}
+}
+
+// GetUsage returns the draws the caller made in its current quota windows.
+func (c Client) GetUsage(ctx context.Context) (*pb.GetUsageReply, error) {
+	return c.randClient.GetUsage(ctx, &pb.GetUsageRequest{})
+}
@@ -0,0 +1,185 @@
+package random
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"math/rand"
+	randv2 "math/rand/v2"
+	"sort"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+// Names of the seeded generator algorithms.
+const (
+	Legacy  = "legacy"
+	PCG     = "pcg"
+	ChaCha8 = "chacha8"
+	Xoshiro = "xoshiro256**"
+)
+
+// algorithm is a seeded generator a RandomRepo is built on. The version must
+// change whenever the output for a given seed changes.
+type algorithm struct {
+	entity.Algorithm
+	// newSource returns the source of the sequence generated by seed
+	newSource func(seed int64) rand.Source
+	// at returns count values of the sequence of seed starting at index,
+	// it is nil when the algorithm cannot jump ahead
+	at func(seed int64, index uint64, count int) ([]int64, error)
+}
+
+var algorithms = map[string]algorithm{
+	Legacy: {
+		Algorithm: entity.Algorithm{Name: Legacy, Version: "1"},
+		newSource: rand.NewSource,
+	},
+	PCG: {
+		Algorithm: entity.Algorithm{Name: PCG, Version: "1"},
+		newSource: func(seed int64) rand.Source {
+			return source64{newPCG(seed)}
+		},
+		at: pcgValues,
+	},
+	ChaCha8: {
+		Algorithm: entity.Algorithm{Name: ChaCha8, Version: "1"},
+		newSource: func(seed int64) rand.Source {
+			var key [32]byte
+			s := uint64(seed)
+			for i := 0; i < len(key); i += 8 {
+				binary.LittleEndian.PutUint64(key[i:], splitMix64(&s))
+			}
+			return source64{randv2.NewChaCha8(key)}
+		},
+	},
+	Xoshiro: {
+		Algorithm: entity.Algorithm{Name: Xoshiro, Version: "1"},
+		newSource: func(seed int64) rand.Source {
+			return source64{newXoshiro256(seed)}
+		},
+	},
+}
+
+// Registry holds a repository for every enabled algorithm.
+type Registry struct {
+	repos            map[string]*RandomRepo
+	defaultAlgorithm string
+	// cached decorates repos once UseCache is called
+	cached map[string]entity.IRandomRepository
+}
+
+func NewRegistry(config *config.Random) (*Registry, error) {
+	disabled := make(map[string]bool, len(config.DisabledAlgorithms))
+	for _, name := range config.DisabledAlgorithms {
+		if _, ok := algorithms[name]; !ok {
+			return nil, fmt.Errorf("unknown algorithm %q", name)
+		}
+		disabled[name] = true
+	}
+
+	r := &Registry{
+		repos:            make(map[string]*RandomRepo, len(algorithms)),
+		defaultAlgorithm: config.DefaultAlgorithm,
+	}
+	for name, algorithm := range algorithms {
+		if !disabled[name] {
+			r.repos[name] = newRepository(algorithm)
+		}
+	}
+	if _, ok := r.repos[r.defaultAlgorithm]; !ok {
+		return nil, fmt.Errorf("default algorithm %q is unknown or disabled", r.defaultAlgorithm)
+	}
+
+	return r, nil
+}
+
+// Get returns the repository of the algorithm name, or of the default algorithm when name is empty.
+func (r *Registry) Get(name string) (entity.IRandomRepository, error) {
+	repo, err := r.repo(name)
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := r.cached[repo.Algorithm().Name]; ok {
+		return cached, nil
+	}
+
+	return repo, nil
+}
+
+// UseCache makes the repositories Get returns cache their numbers in cache.
+// It must be called before the registry is shared.
+func (r *Registry) UseCache(cache entity.INumberCache) {
+	r.cached = make(map[string]entity.IRandomRepository, len(r.repos))
+	for name, repo := range r.repos {
+		r.cached[name] = NewCacheRepository(repo, cache)
+	}
+}
+
+func (r *Registry) repo(name string) (*RandomRepo, error) {
+	if name == "" {
+		name = r.defaultAlgorithm
+	}
+
+	repo, ok := r.repos[name]
+	if !ok {
+		return nil, fmt.Errorf("validate: algorithm %q is unknown or disabled, expected one of %v", name, r.Names())
+	}
+
+	return repo, nil
+}
+
+// Names returns the sorted names of the enabled algorithms.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.repos))
+	for name := range r.repos {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// source64 adapts a math/rand/v2 source to the math/rand one the repository is built on.
+type source64 struct {
+	randv2.Source
+}
+
+func (s source64) Int63() int64 {
+	return int64(s.Uint64() &^ (1 << 63))
+}
+
+// Seed is never called, sources are created for a single seed.
+func (s source64) Seed(int64) {}
+
+// xoshiro256 is the xoshiro256** generator of Blackman and Vigna.
+type xoshiro256 struct {
+	s [4]uint64
+}
+
+// newXoshiro256 expands seed into the 256-bit state with SplitMix64,
+// as recommended by the authors.
+func newXoshiro256(seed int64) *xoshiro256 {
+	x := &xoshiro256{}
+	s := uint64(seed)
+	for i := range x.s {
+		x.s[i] = splitMix64(&s)
+	}
+
+	return x
+}
+
+func (x *xoshiro256) Uint64() uint64 {
+	result := bits.RotateLeft64(x.s[1]*5, 7) * 9
+	t := x.s[1] << 17
+
+	x.s[2] ^= x.s[0]
+	x.s[3] ^= x.s[1]
+	x.s[1] ^= x.s[2]
+	x.s[0] ^= x.s[3]
+	x.s[2] ^= t
+	x.s[3] = bits.RotateLeft64(x.s[3], 45)
+
+	return result
+}
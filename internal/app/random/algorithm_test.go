@@ -0,0 +1,128 @@
+package random
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+func TestNewRegistry(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      config.Random
+		expectNames []string
+		expectError bool
+	}{
+		{
+			name:        "Every Algorithm",
+			config:      config.Random{DefaultAlgorithm: Legacy},
+			expectNames: []string{ChaCha8, Legacy, PCG, Xoshiro},
+		},
+		{
+			name:        "Disabled Algorithms",
+			config:      config.Random{DefaultAlgorithm: PCG, DisabledAlgorithms: []string{Legacy, ChaCha8}},
+			expectNames: []string{PCG, Xoshiro},
+		},
+		{
+			name:        "Disabled Default",
+			config:      config.Random{DefaultAlgorithm: Legacy, DisabledAlgorithms: []string{Legacy}},
+			expectError: true,
+		},
+		{
+			name:        "Unknown Default",
+			config:      config.Random{DefaultAlgorithm: "mt19937"},
+			expectError: true,
+		},
+		{
+			name:        "Unknown Disabled Algorithm",
+			config:      config.Random{DefaultAlgorithm: Legacy, DisabledAlgorithms: []string{"mt19937"}},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry, err := NewRegistry(&tt.config)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectNames, registry.Names())
+
+			repo, err := registry.Get("")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.config.DefaultAlgorithm, repo.Algorithm().Name)
+
+			for _, name := range tt.config.DisabledAlgorithms {
+				_, err := registry.Get(name)
+				assert.ErrorContains(t, err, "validate", "Expected %s to be disabled", name)
+			}
+		})
+	}
+}
+
+func TestAlgorithms_Reproducible(t *testing.T) {
+	ctx := context.Background()
+	seen := map[int64]string{}
+	for name, algorithm := range algorithms {
+		repo := newRepository(algorithm)
+		first, err := repo.Get(ctx, 42)
+		assert.NoError(t, err)
+		again, err := repo.Get(ctx, 42)
+		assert.NoError(t, err)
+		assert.Equal(t, first.Number, again.Number, "Expected %s to be reproducible", name)
+		assert.GreaterOrEqual(t, first.Number, int64(0))
+
+		other, ok := seen[first.Number]
+		assert.False(t, ok, "Expected %s and %s to generate different values", name, other)
+		seen[first.Number] = name
+	}
+}
+
+func TestAlgorithms_Legacy(t *testing.T) {
+	randNum, err := NewRepository().Get(context.Background(), 42)
+	assert.NoError(t, err)
+	assert.Equal(t, rand.New(rand.NewSource(42)).Int63(), randNum.Number, "Expected legacy to keep the math/rand sequence")
+}
+
+func TestAlgorithms_PCGJumpMatchesStream(t *testing.T) {
+	repo := newRepository(algorithms[PCG])
+	ctx := context.Background()
+
+	var streamed []int64
+	err := repo.Stream(ctx, 42, 20, func(randNum entity.Random) error {
+		streamed = append(streamed, randNum.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	sequence, err := repo.GetAt(ctx, 42, 5, 15)
+	assert.NoError(t, err)
+	assert.Equal(t, streamed[5:], sequence.Numbers, "Expected GetAt to read the sequence Stream generates")
+}
+
+func TestXoshiro256(t *testing.T) {
+	// Reference output of xoshiro256** for the state {1, 2, 3, 4}
+	x := &xoshiro256{s: [4]uint64{1, 2, 3, 4}}
+	expected := []uint64{
+		11520,
+		0,
+		1509978240,
+		1215971899390074240,
+		1216172134540287360,
+		607988272756665600,
+		16172922978634559625,
+		8476171486693032832,
+		10595114339597558777,
+		2904607092377533576,
+	}
+	for _, want := range expected {
+		assert.Equal(t, want, x.Uint64())
+	}
+}
@@ -1,12 +1,21 @@
 package random
 
 import (
+	"bufio"
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
 
 	"github.com/bufbuild/protovalidate-go"
 
 	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
 	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
 	"github.com/minhthong582000/soa-404/pkg/tracing"
 )
 
@@ -30,12 +39,1063 @@ func (s RandomServer) GetRandNumber(ctx context.Context, request *pb.GetRandNumb
 		return nil, err
 	}
 
-	randNum, err := s.RandomService.Get(ctx, request.SeedNum)
+	mode := modeFromProto(request.Mode)
+	algorithm, err := s.RandomService.Algorithm(mode, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	randNum, err := s.RandomService.Get(ctx, request.SeedNum, mode, request.Algorithm)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetRandNumberReply{
-		Number: randNum.Number,
+		Number:           randNum.Number,
+		Mode:             modeToProto(randNum.Mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+		Signature:        signatureToProto(randNum.Signature),
+	}, nil
+}
+
+func (s RandomServer) StreamRandNumbers(request *pb.StreamRandNumbersRequest, stream pb.RandomService_StreamRandNumbersServer) error {
+	tracer := tracing.GetTracer()
+	ctx := tracer.StartSpan(stream.Context(), "RandomService.Handler.StreamRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return err
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	// Send blocks while the client's flow control window is full,
+	// so the generator never runs ahead of what the client consumes.
+	var index int64
+	return s.RandomService.Stream(ctx, request.SeedNum, request.Algorithm, request.Count, func(randNum entity.Random) error {
+		if err := stream.Send(&pb.StreamRandNumbersReply{
+			Index:            index,
+			Number:           randNum.Number,
+			Algorithm:        algorithm.Name,
+			AlgorithmVersion: algorithm.Version,
+		}); err != nil {
+			return err
+		}
+		index++
+
+		return nil
+	})
+}
+
+func (s RandomServer) GetRandNumbers(ctx context.Context, request *pb.GetRandNumbersRequest) (*pb.GetRandNumbersReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.RandomService.GetBatch(ctx, request.SeedNums, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetRandNumbersReply{
+		Results:          make([]*pb.RandNumberResult, 0, len(results)),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}
+	for _, result := range results {
+		item := &pb.RandNumberResult{
+			SeedNum: result.Seed,
+		}
+		if result.Err != nil {
+			item.Result = &pb.RandNumberResult_Error{
+				Error: &pb.Status{
+					Code:    int32(grpc_errors.ParseGRPCErrStatusCode(result.Err)),
+					Message: result.Err.Error(),
+				},
+			}
+		} else {
+			item.Result = &pb.RandNumberResult_Number{
+				Number: result.Random.Number,
+			}
+		}
+		reply.Results = append(reply.Results, item)
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) GetRandNumberInRange(ctx context.Context, request *pb.GetRandNumberInRangeRequest) (*pb.GetRandNumberInRangeReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetRandNumberInRange")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	switch r := request.Range.(type) {
+	case *pb.GetRandNumberInRangeRequest_IntRange:
+		randNum, err := s.RandomService.GetInIntRange(ctx, request.SeedNum, request.Algorithm, entity.IntRange{
+			Min: r.IntRange.Min,
+			Max: r.IntRange.Max,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.GetRandNumberInRangeReply{
+			Value:            &pb.GetRandNumberInRangeReply_Number{Number: randNum.Number},
+			Algorithm:        algorithm.Name,
+			AlgorithmVersion: algorithm.Version,
+			Signature:        signatureToProto(randNum.Signature),
+		}, nil
+	case *pb.GetRandNumberInRangeRequest_FloatRange:
+		randNum, err := s.RandomService.GetInFloatRange(ctx, request.SeedNum, request.Algorithm, entity.FloatRange{
+			Min: r.FloatRange.Min,
+			Max: r.FloatRange.Max,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return &pb.GetRandNumberInRangeReply{
+			Value:            &pb.GetRandNumberInRangeReply_Float{Float: randNum.Float},
+			Algorithm:        algorithm.Name,
+			AlgorithmVersion: algorithm.Version,
+			Signature:        signatureToProto(randNum.Signature),
+		}, nil
+	}
+
+	return nil, errors.New("validate: range is required")
+}
+
+func (s RandomServer) WeightedChoice(ctx context.Context, request *pb.WeightedChoiceRequest) (*pb.WeightedChoiceReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.WeightedChoice")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(request.Count)
+	if count == 0 {
+		count = 1
+	}
+
+	weights := make([]float64, 0, len(request.Items))
+	for _, item := range request.Items {
+		weights = append(weights, item.Weight)
+	}
+
+	indices, err := s.RandomService.Choose(ctx, request.SeedNum, request.Algorithm, weights, count)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.WeightedChoiceReply{
+		Items:            make([]string, 0, len(indices)),
+		Indices:          indices,
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}
+	for _, i := range indices {
+		reply.Items = append(reply.Items, request.Items[i].Value)
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) Roll(ctx context.Context, request *pb.RollRequest) (*pb.RollReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.Roll")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	roll, err := s.RandomService.Roll(ctx, request.SeedNum, request.Algorithm, request.Dice)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.RollReply{
+		Total:            roll.Total,
+		Modifier:         roll.Modifier,
+		Dice:             make([]*pb.Die, 0, len(roll.Dice)),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}
+	for _, die := range roll.Dice {
+		reply.Dice = append(reply.Dice, &pb.Die{
+			Term:     int32(die.Term),
+			Sides:    die.Sides,
+			Value:    die.Value,
+			Kept:     die.Kept,
+			Exploded: die.Exploded,
+		})
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) SampleDistribution(ctx context.Context, request *pb.SampleDistributionRequest) (*pb.SampleDistributionReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.SampleDistribution")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	var dist entity.Distribution
+	switch d := request.Distribution.(type) {
+	case *pb.SampleDistributionRequest_Normal:
+		dist = entity.Distribution{Kind: entity.Normal, Mean: d.Normal.Mean, StdDev: d.Normal.StdDev}
+	case *pb.SampleDistributionRequest_Exponential:
+		dist = entity.Distribution{Kind: entity.Exponential, Rate: d.Exponential.Rate}
+	case *pb.SampleDistributionRequest_Poisson:
+		dist = entity.Distribution{Kind: entity.Poisson, Lambda: d.Poisson.Lambda}
+	case *pb.SampleDistributionRequest_Binomial:
+		dist = entity.Distribution{Kind: entity.Binomial, N: d.Binomial.N, P: d.Binomial.P}
+	case *pb.SampleDistributionRequest_Uniform:
+		dist = entity.Distribution{Kind: entity.Uniform, Min: d.Uniform.Min, Max: d.Uniform.Max}
+	default:
+		return nil, errors.New("validate: distribution is required")
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	samples, err := s.RandomService.Sample(ctx, request.SeedNum, request.Algorithm, dist, request.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SampleDistributionReply{
+		Samples:          samples,
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
 	}, nil
 }
+
+func modeFromProto(mode pb.Mode) entity.Mode {
+	if mode == pb.Mode_MODE_SECURE {
+		return entity.Secure
+	}
+
+	return entity.Seeded
+}
+
+func signatureToProto(signature *entity.Signature) *pb.Signature {
+	if signature == nil {
+		return nil
+	}
+
+	return &pb.Signature{
+		KeyID:     signature.KeyID,
+		Timestamp: signature.Timestamp.UnixNano(),
+		Value:     signature.Value,
+	}
+}
+
+func modeToProto(mode entity.Mode) pb.Mode {
+	switch mode {
+	case entity.Seeded:
+		return pb.Mode_MODE_SEEDED
+	case entity.Secure:
+		return pb.Mode_MODE_SECURE
+	}
+
+	return pb.Mode_MODE_UNSPECIFIED
+}
+
+func (s RandomServer) GetRandBytes(ctx context.Context, request *pb.GetRandBytesRequest) (*pb.GetRandBytesReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetRandBytes")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	mode := modeFromProto(request.Mode)
+	algorithm, err := s.RandomService.Algorithm(mode, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.RandomService.GetBytes(ctx, request.SeedNum, mode, request.Algorithm, int(request.Length))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetRandBytesReply{
+		Mode:             modeToProto(mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}
+	if request.Encoding == pb.Encoding_ENCODING_BASE64 {
+		reply.Data = base64.StdEncoding.EncodeToString(b)
+		reply.Encoding = pb.Encoding_ENCODING_BASE64
+	} else {
+		reply.Data = hex.EncodeToString(b)
+		reply.Encoding = pb.Encoding_ENCODING_HEX
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) GetUUIDs(ctx context.Context, request *pb.GetUUIDsRequest) (*pb.GetUUIDsReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetUUIDs")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	version := request.Version
+	if version == pb.UUIDVersion_UUID_VERSION_UNSPECIFIED {
+		version = pb.UUIDVersion_UUID_VERSION_4
+	}
+
+	mode := modeFromProto(request.Mode)
+	algorithm, err := s.RandomService.Algorithm(mode, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids, err := s.RandomService.GetUUIDs(ctx, request.SeedNum, mode, request.Algorithm, int(version), int(request.Count))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUUIDsReply{
+		UUIDs:            uuids,
+		Version:          version,
+		Mode:             modeToProto(mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}, nil
+}
+
+func (s RandomServer) GetToken(ctx context.Context, request *pb.GetTokenRequest) (*pb.GetTokenReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetToken")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	mode := modeFromProto(request.Mode)
+	algorithm, err := s.RandomService.Algorithm(mode, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.RandomService.GetToken(ctx, request.SeedNum, mode, request.Algorithm, int(request.Length))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetTokenReply{
+		Token:            token,
+		Mode:             modeToProto(mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}, nil
+}
+
+func (s RandomServer) GenerateRecords(request *pb.GenerateRecordsRequest, stream pb.RandomService_GenerateRecordsServer) error {
+	tracer := tracing.GetTracer()
+	ctx := tracer.StartSpan(stream.Context(), "RandomService.Handler.GenerateRecords")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return err
+	}
+
+	schema := make([]entity.FieldSpec, 0, len(request.Fields))
+	for _, field := range request.Fields {
+		spec, err := fieldSpecFromProto(field)
+		if err != nil {
+			return err
+		}
+		schema = append(schema, spec)
+	}
+
+	err := s.RandomService.GenerateRecords(ctx, request.SeedNum, request.Algorithm, schema, request.Count, func(record entity.Record) error {
+		reply := &pb.GenerateRecordsReply{
+			Index:  record.Index,
+			Fields: make([]*pb.FieldValue, 0, len(record.Fields)),
+		}
+		for _, field := range record.Fields {
+			value := &pb.FieldValue{Name: field.Name}
+			if field.Kind == entity.IntField {
+				value.Value = &pb.FieldValue_Int{Int: field.Int}
+			} else {
+				value.Value = &pb.FieldValue_String_{String_: field.String}
+			}
+			reply.Fields = append(reply.Fields, value)
+		}
+
+		return stream.Send(reply)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func fieldSpecFromProto(field *pb.FieldSpec) (entity.FieldSpec, error) {
+	spec := entity.FieldSpec{Name: field.Name}
+	switch t := field.Type.(type) {
+	case *pb.FieldSpec_PersonName:
+		spec.Kind = entity.PersonName
+	case *pb.FieldSpec_Email:
+		spec.Kind = entity.Email
+		spec.Domain = t.Email.Domain
+	case *pb.FieldSpec_IntRange:
+		spec.Kind = entity.IntField
+		spec.IntRange = entity.IntRange{Min: t.IntRange.Min, Max: t.IntRange.Max}
+	case *pb.FieldSpec_DateRange:
+		spec.Kind = entity.DateField
+		min, err := time.Parse(time.DateOnly, t.DateRange.Min)
+		if err != nil {
+			return spec, fmt.Errorf("validate: field %q: invalid first day: %w", field.Name, err)
+		}
+		max, err := time.Parse(time.DateOnly, t.DateRange.Max)
+		if err != nil {
+			return spec, fmt.Errorf("validate: field %q: invalid last day: %w", field.Name, err)
+		}
+		spec.DateRange = entity.DateRange{Min: min, Max: max}
+	case *pb.FieldSpec_Enum:
+		spec.Kind = entity.EnumField
+		spec.Values = t.Enum.Values
+	case *pb.FieldSpec_UUID:
+		spec.Kind = entity.UUIDField
+	default:
+		return spec, fmt.Errorf("validate: field %q has no type", field.Name)
+	}
+
+	return spec, nil
+}
+
+func (s RandomServer) GetRandStrings(ctx context.Context, request *pb.GetRandStringsRequest) (*pb.GetRandStringsReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetRandStrings")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	mode := modeFromProto(request.Mode)
+	algorithm, err := s.RandomService.Algorithm(mode, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	count := int(request.Count)
+	if count == 0 {
+		count = 1
+	}
+
+	strings, err := s.RandomService.GetStrings(ctx, request.SeedNum, mode, request.Algorithm, entity.StringSpec{
+		Pattern: request.GetPattern(),
+		Charset: request.GetCharset(),
+		Length:  int(request.Length),
+	}, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRandStringsReply{
+		Strings:          strings,
+		Mode:             modeToProto(mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}, nil
+}
+
+func (s RandomServer) Shuffle(ctx context.Context, request *pb.ShuffleRequest) (*pb.ShuffleReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.Shuffle")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	var items []string
+	n := request.GetSize()
+	if request.GetItems() != nil {
+		items = request.GetItems().Values
+		n = int64(len(items))
+	}
+
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	permutation, err := s.RandomService.Shuffle(ctx, request.SeedNum, request.Algorithm, n, request.SampleSize)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ShuffleReply{
+		Indices:          permutation.Indices,
+		ShuffleAlgorithm: permutation.ShuffleAlgorithm,
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}
+	if items != nil {
+		reply.Items = make([]string, 0, len(permutation.Indices))
+		for _, i := range permutation.Indices {
+			reply.Items = append(reply.Items, items[i])
+		}
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) GetRandNumberAt(ctx context.Context, request *pb.GetRandNumberAtRequest) (*pb.GetRandNumberAtReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetRandNumberAt")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	count := int(request.Count)
+	if count == 0 {
+		count = 1
+	}
+
+	name := request.Algorithm
+	if name == "" {
+		name = PCG
+	}
+	algorithm, err := s.RandomService.Algorithm(entity.Seeded, name)
+	if err != nil {
+		return nil, err
+	}
+
+	sequence, err := s.RandomService.GetAt(ctx, request.SeedNum, name, request.Index, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetRandNumberAtReply{
+		Numbers:          sequence.Numbers,
+		Index:            sequence.Index,
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+	}, nil
+}
+
+func (s RandomServer) CreateGenerator(ctx context.Context, request *pb.CreateGeneratorRequest) (*pb.CreateGeneratorReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.CreateGenerator")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	generator, err := s.RandomService.CreateGenerator(ctx, request.SeedNum, request.Algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateGeneratorReply{
+		GeneratorID:        generator.ID,
+		Algorithm:          generator.Algorithm.Name,
+		AlgorithmVersion:   generator.Algorithm.Version,
+		IdleTimeoutSeconds: int64(generator.IdleTimeout.Seconds()),
+	}, nil
+}
+
+func (s RandomServer) Next(ctx context.Context, request *pb.NextRequest) (*pb.NextReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.Next")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	count := int(request.Count)
+	if count == 0 {
+		count = 1
+	}
+
+	sequence, err := s.RandomService.Next(ctx, request.GeneratorID, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.NextReply{
+		Numbers: sequence.Numbers,
+		Index:   sequence.Index,
+	}, nil
+}
+
+func (s RandomServer) CloseGenerator(ctx context.Context, request *pb.CloseGeneratorRequest) (*pb.CloseGeneratorReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.CloseGenerator")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	if err := s.RandomService.CloseGenerator(ctx, request.GeneratorID); err != nil {
+		return nil, err
+	}
+
+	return &pb.CloseGeneratorReply{}, nil
+}
+
+func (s RandomServer) DrawSession(stream pb.RandomService_DrawSessionServer) error {
+	tracer := tracing.GetTracer()
+	ctx := tracer.StartSpan(stream.Context(), "RandomService.Handler.DrawSession")
+	defer tracer.EndSpan(ctx)
+
+	session := s.RandomService.NewDrawSession()
+	for {
+		command, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := protovalidate.Validate(command); err != nil {
+			return err
+		}
+		result, err := draw(session, command)
+		if err != nil {
+			return fmt.Errorf("command %d: %w", command.ID, err)
+		}
+		result.ID = command.ID
+
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+// draw runs command on session.
+func draw(session entity.IDrawSession, command *pb.DrawCommand) (*pb.DrawResult, error) {
+	var (
+		sequence entity.Sequence
+		err      error
+	)
+	switch c := command.Command.(type) {
+	case *pb.DrawCommand_Reseed:
+		algorithm, err := session.Reseed(c.Reseed.SeedNum, c.Reseed.Algorithm)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.DrawResult{
+			Algorithm:        algorithm.Name,
+			AlgorithmVersion: algorithm.Version,
+		}, nil
+	case *pb.DrawCommand_NextInt:
+		sequence, err = session.Next(int(max(c.NextInt.Count, 1)))
+	case *pb.DrawCommand_NextInRange:
+		sequence, err = session.NextInRange(entity.IntRange{
+			Min: c.NextInRange.Range.Min,
+			Max: c.NextInRange.Range.Max,
+		}, int(max(c.NextInRange.Count, 1)))
+	case *pb.DrawCommand_Skip:
+		sequence, err = session.Skip(c.Skip.N)
+	default:
+		return nil, errors.New("validate: command is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DrawResult{
+		Numbers: sequence.Numbers,
+		Index:   sequence.Index,
+	}, nil
+}
+
+func (s RandomServer) CreateCommitment(ctx context.Context, request *pb.CreateCommitmentRequest) (*pb.CreateCommitmentReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.CreateCommitment")
+	defer tracer.EndSpan(ctx)
+
+	commitment, err := s.RandomService.CreateCommitment(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateCommitmentReply{
+		CommitmentID: commitment.ID,
+		Commitment:   commitment.Hash,
+		ExpiresAt:    commitment.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (s RandomServer) Draw(ctx context.Context, request *pb.DrawRequest) (*pb.DrawReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.Draw")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	bounds := entity.IntRange{Min: 0, Max: math.MaxInt64}
+	if request.Range != nil {
+		bounds = entity.IntRange{Min: request.Range.Min, Max: request.Range.Max}
+	}
+
+	draw, err := s.RandomService.Draw(ctx, request.CommitmentID, request.ClientSeed, bounds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DrawReply{
+		Number:     draw.Number,
+		Commitment: draw.Commitment.Hash,
+		Secret:     hex.EncodeToString(draw.Commitment.Secret),
+		ClientSeed: draw.ClientSeed,
+		Range:      &pb.IntRange{Min: draw.Range.Min, Max: draw.Range.Max},
+	}, nil
+}
+
+func (s RandomServer) GetPublicKey(ctx context.Context, request *pb.GetPublicKeyRequest) (*pb.GetPublicKeyReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetPublicKey")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	key, err := s.RandomService.GetPublicKey(ctx, request.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPublicKeyReply{
+		KeyID:     key.KeyID,
+		PublicKey: key.Key,
+		Active:    key.Active,
+	}, nil
+}
+
+func (s RandomServer) SubmitJob(ctx context.Context, request *pb.SubmitJobRequest) (*pb.SubmitJobReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.SubmitJob")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	spec := entity.JobSpec{
+		Seed:      request.SeedNum,
+		Algorithm: request.Algorithm,
+		Samples:   request.Samples,
+	}
+	switch estimate := request.Estimate.(type) {
+	case *pb.SubmitJobRequest_Pi:
+		spec.Kind = entity.PiJob
+	case *pb.SubmitJobRequest_Integral:
+		spec.Kind = entity.IntegralJob
+		spec.Expression = estimate.Integral.Expression
+		spec.Range = entity.FloatRange{Min: estimate.Integral.Min, Max: estimate.Integral.Max}
+	}
+
+	job, err := s.RandomService.SubmitJob(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SubmitJobReply{Job: jobToProto(job)}, nil
+}
+
+func (s RandomServer) GetJob(ctx context.Context, request *pb.GetJobRequest) (*pb.GetJobReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetJob")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	job, err := s.RandomService.GetJob(ctx, request.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetJobReply{Job: jobToProto(job)}, nil
+}
+
+func (s RandomServer) CancelJob(ctx context.Context, request *pb.CancelJobRequest) (*pb.CancelJobReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.CancelJob")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	job, err := s.RandomService.CancelJob(ctx, request.JobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CancelJobReply{Job: jobToProto(job)}, nil
+}
+
+func (s RandomServer) ListJobs(ctx context.Context, request *pb.ListJobsRequest) (*pb.ListJobsReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.ListJobs")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	jobs, err := s.RandomService.ListJobs(ctx, jobStateFromProto(request.State))
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListJobsReply{Jobs: make([]*pb.Job, 0, len(jobs))}
+	for i := range jobs {
+		reply.Jobs = append(reply.Jobs, jobToProto(&jobs[i]))
+	}
+
+	return reply, nil
+}
+
+var jobStates = map[entity.JobState]pb.JobState{
+	entity.JobQueued:    pb.JobState_JOB_STATE_QUEUED,
+	entity.JobRunning:   pb.JobState_JOB_STATE_RUNNING,
+	entity.JobSucceeded: pb.JobState_JOB_STATE_SUCCEEDED,
+	entity.JobFailed:    pb.JobState_JOB_STATE_FAILED,
+	entity.JobCancelled: pb.JobState_JOB_STATE_CANCELLED,
+}
+
+// jobStateFromProto maps an unspecified state to the empty state.
+func jobStateFromProto(state pb.JobState) entity.JobState {
+	for s, p := range jobStates {
+		if p == state {
+			return s
+		}
+	}
+
+	return ""
+}
+
+func jobToProto(job *entity.Job) *pb.Job {
+	request := &pb.SubmitJobRequest{
+		SeedNum:   job.Spec.Seed,
+		Algorithm: job.Spec.Algorithm,
+		Samples:   job.Spec.Samples,
+	}
+	switch job.Spec.Kind {
+	case entity.PiJob:
+		request.Estimate = &pb.SubmitJobRequest_Pi{Pi: &pb.PiEstimate{}}
+	case entity.IntegralJob:
+		request.Estimate = &pb.SubmitJobRequest_Integral{Integral: &pb.IntegralEstimate{
+			Expression: job.Spec.Expression,
+			Min:        job.Spec.Range.Min,
+			Max:        job.Spec.Range.Max,
+		}}
+	}
+
+	return &pb.Job{
+		JobID:            job.ID,
+		State:            jobStates[job.State],
+		Request:          request,
+		Algorithm:        job.Algorithm.Name,
+		AlgorithmVersion: job.Algorithm.Version,
+		Progress:         job.Progress,
+		Estimate:         job.Result.Estimate,
+		StdError:         job.Result.StdError,
+		Error:            job.Error,
+		CreatedAt:        unixNano(job.CreatedAt),
+		StartedAt:        unixNano(job.StartedAt),
+		FinishedAt:       unixNano(job.FinishedAt),
+	}
+}
+
+// defaultDrawPageSize is the page size of ListDraws when the request leaves
+// it unset.
+const defaultDrawPageSize = 100
+
+func (s RandomServer) ListDraws(ctx context.Context, request *pb.ListDrawsRequest) (*pb.ListDrawsReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.ListDraws")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return nil, err
+	}
+
+	filter := entity.DrawFilter{
+		From:      fromUnixNano(request.From),
+		To:        fromUnixNano(request.To),
+		Seed:      request.SeedNum,
+		ClientIP:  request.ClientIP,
+		RequestID: request.RequestID,
+	}
+	pageSize := int(request.PageSize)
+	if pageSize == 0 {
+		pageSize = defaultDrawPageSize
+	}
+
+	page, err := s.RandomService.ListDraws(ctx, filter, request.PageToken, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ListDrawsReply{
+		Draws:         make([]*pb.DrawRecord, 0, len(page.Draws)),
+		NextPageToken: page.NextCursor,
+	}
+	for _, draw := range page.Draws {
+		reply.Draws = append(reply.Draws, &pb.DrawRecord{
+			Sequence:         draw.Sequence,
+			Timestamp:        unixNano(draw.Timestamp),
+			RequestID:        draw.RequestID,
+			ClientIP:         draw.ClientIP,
+			Method:           draw.Method,
+			SeedNum:          draw.Seed,
+			Algorithm:        draw.Algorithm.Name,
+			AlgorithmVersion: draw.Algorithm.Version,
+			Output:           string(draw.Output),
+		})
+	}
+
+	return reply, nil
+}
+
+var quotaWindows = map[entity.QuotaWindow]pb.QuotaWindow{
+	entity.Daily:   pb.QuotaWindow_QUOTA_WINDOW_DAILY,
+	entity.Monthly: pb.QuotaWindow_QUOTA_WINDOW_MONTHLY,
+}
+
+func (s RandomServer) GetUsage(ctx context.Context, request *pb.GetUsageRequest) (*pb.GetUsageReply, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Handler.GetUsage")
+	defer tracer.EndSpan(ctx)
+
+	usage, err := s.RandomService.GetUsage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetUsageReply{
+		Client: usage.Client,
+		Tier:   usage.Tier,
+		Quotas: make([]*pb.QuotaUsage, 0, len(usage.Quotas)),
+	}
+	for _, quota := range usage.Quotas {
+		reply.Quotas = append(reply.Quotas, &pb.QuotaUsage{
+			Window:   quotaWindows[quota.Window],
+			Used:     quota.Used,
+			Limit:    quota.Limit,
+			ResetsAt: unixNano(quota.ResetsAt),
+		})
+	}
+
+	return reply, nil
+}
+
+func (s RandomServer) ExportDraws(request *pb.ExportDrawsRequest, stream pb.RandomService_ExportDrawsServer) error {
+	tracer := tracing.GetTracer()
+	ctx := tracer.StartSpan(stream.Context(), "RandomService.Handler.ExportDraws")
+	defer tracer.EndSpan(ctx)
+
+	if err := protovalidate.Validate(request); err != nil {
+		return err
+	}
+
+	// gRPC serializes messages as they are sent, so the buffer can be reused
+	buffer := bufio.NewWriterSize(chunkWriter(func(chunk []byte) error {
+		return stream.Send(&pb.ExportDrawsReply{Chunk: chunk})
+	}), exportChunkSize)
+	encoder := newDrawEncoder(request.Format, buffer)
+
+	filter := entity.DrawFilter{
+		From: fromUnixNano(request.From),
+		To:   fromUnixNano(request.To),
+	}
+	err := s.RandomService.ExportDraws(ctx, filter, encoder.Encode)
+	if err == nil {
+		err = encoder.Flush()
+	}
+	if err == nil {
+		err = buffer.Flush()
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// fromUnixNano maps 0 to the zero time.
+func fromUnixNano(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ns)
+}
+
+// unixNano returns 0 for the zero time.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
@@ -1 +1,657 @@
 package random
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/app/client"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/fair"
+	"github.com/minhthong582000/soa-404/pkg/middleware"
+	"github.com/minhthong582000/soa-404/pkg/signing"
+)
+
+func TestRandomServer_GetRandNumberInRange(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *pb.GetRandNumberInRangeRequest
+		expectError bool
+	}{
+		{
+			name: "Valid Int Range",
+			request: &pb.GetRandNumberInRangeRequest{
+				SeedNum: 42,
+				Range:   &pb.GetRandNumberInRangeRequest_IntRange{IntRange: &pb.IntRange{Min: 1, Max: 6}},
+			},
+		},
+		{
+			name: "Valid Float Range",
+			request: &pb.GetRandNumberInRangeRequest{
+				SeedNum: 42,
+				Range:   &pb.GetRandNumberInRangeRequest_FloatRange{FloatRange: &pb.FloatRange{Min: 0, Max: 1}},
+			},
+		},
+		{
+			name: "Min Greater Than Max",
+			request: &pb.GetRandNumberInRangeRequest{
+				SeedNum: 42,
+				Range:   &pb.GetRandNumberInRangeRequest_IntRange{IntRange: &pb.IntRange{Min: 6, Max: 1}},
+			},
+			expectError: true,
+		},
+		{
+			name:        "Missing Range",
+			request:     &pb.GetRandNumberInRangeRequest{SeedNum: 42},
+			expectError: true,
+		},
+	}
+
+	server := NewServer(newTestService())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply, err := server.GetRandNumberInRange(context.Background(), tt.request)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, reply.Value)
+		})
+	}
+}
+
+func TestRandomServer_GetRandNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		request     *pb.GetRandNumberRequest
+		expectMode  pb.Mode
+		expectError bool
+	}{
+		{
+			name:       "Default Mode",
+			request:    &pb.GetRandNumberRequest{SeedNum: 42},
+			expectMode: pb.Mode_MODE_SEEDED,
+		},
+		{
+			name:        "Seeded Mode Small Seed",
+			request:     &pb.GetRandNumberRequest{SeedNum: 2, Mode: pb.Mode_MODE_SEEDED},
+			expectError: true,
+		},
+		{
+			name:       "Secure Mode Without Seed",
+			request:    &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE},
+			expectMode: pb.Mode_MODE_SECURE,
+		},
+		{
+			name:        "Undefined Mode",
+			request:     &pb.GetRandNumberRequest{SeedNum: 42, Mode: pb.Mode(42)},
+			expectError: true,
+		},
+	}
+
+	server := NewServer(newTestService())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply, err := server.GetRandNumber(context.Background(), tt.request)
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectMode, reply.Mode)
+		})
+	}
+}
+
+func TestRandomServer_Shuffle(t *testing.T) {
+	server := NewServer(newTestService())
+	ctx := context.Background()
+	items := []string{"a", "b", "c", "d", "e"}
+
+	reply, err := server.Shuffle(ctx, &pb.ShuffleRequest{
+		SeedNum:    42,
+		Population: &pb.ShuffleRequest_Items{Items: &pb.ShuffleItems{Values: items}},
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, items, reply.Items)
+	for i, index := range reply.Indices {
+		assert.Equal(t, items[index], reply.Items[i], "Expected Items to follow Indices")
+	}
+
+	reply, err = server.Shuffle(ctx, &pb.ShuffleRequest{
+		SeedNum:    42,
+		Population: &pb.ShuffleRequest_Size{Size: 1000},
+		SampleSize: 3,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Indices, 3)
+	assert.Empty(t, reply.Items)
+
+	_, err = server.Shuffle(ctx, &pb.ShuffleRequest{
+		SeedNum:    42,
+		Population: &pb.ShuffleRequest_Items{Items: &pb.ShuffleItems{Values: items}},
+		SampleSize: 6,
+	})
+	assert.Error(t, err, "Expected a sample larger than the population to be rejected")
+}
+
+func TestRandomServer_Algorithm(t *testing.T) {
+	server := NewServer(newTestService())
+	ctx := context.Background()
+
+	reply, err := server.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 42})
+	assert.NoError(t, err)
+	assert.Equal(t, Legacy, reply.Algorithm, "Expected the default algorithm to be reported")
+	assert.NotEmpty(t, reply.AlgorithmVersion)
+
+	reply, err = server.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 42, Algorithm: ChaCha8})
+	assert.NoError(t, err)
+	assert.Equal(t, ChaCha8, reply.Algorithm)
+
+	at, err := server.GetRandNumberAt(ctx, &pb.GetRandNumberAtRequest{SeedNum: 42, Index: 10})
+	assert.NoError(t, err)
+	assert.Equal(t, PCG, at.Algorithm, "Expected GetRandNumberAt to default to pcg")
+
+	_, err = server.GetRandNumberAt(ctx, &pb.GetRandNumberAtRequest{SeedNum: 42, Algorithm: Xoshiro})
+	assert.Error(t, err, "Expected algorithms that cannot jump ahead to be rejected")
+}
+
+func TestRandomServer_GeneratorSession(t *testing.T) {
+	randClient := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := randClient.CreateGenerator(ctx, &pb.CreateGeneratorRequest{SeedNum: 42, Algorithm: PCG})
+	assert.NoError(t, err)
+	assert.Equal(t, PCG, created.Algorithm)
+
+	next, err := randClient.Next(ctx, &pb.NextRequest{GeneratorID: created.GeneratorID, Count: 3})
+	assert.NoError(t, err)
+	assert.Len(t, next.Numbers, 3)
+	next, err = randClient.Next(ctx, &pb.NextRequest{GeneratorID: created.GeneratorID})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), next.Index)
+
+	_, err = randClient.CloseGenerator(ctx, &pb.CloseGeneratorRequest{GeneratorID: created.GeneratorID})
+	assert.NoError(t, err)
+	_, err = randClient.Next(ctx, &pb.NextRequest{GeneratorID: created.GeneratorID})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Expected a closed generator to be reported as not found")
+}
+
+func TestRandomServer_WeightedChoice(t *testing.T) {
+	tests := []struct {
+		name        string
+		items       []*pb.WeightedItem
+		expectError bool
+	}{
+		{
+			name:  "Valid Weights",
+			items: []*pb.WeightedItem{{Value: "a", Weight: 1}, {Value: "b", Weight: 0}},
+		},
+		{
+			name:        "Negative Weight",
+			items:       []*pb.WeightedItem{{Value: "a", Weight: 1}, {Value: "b", Weight: -1}},
+			expectError: true,
+		},
+		{
+			name:        "No Positive Weight",
+			items:       []*pb.WeightedItem{{Value: "a"}, {Value: "b"}},
+			expectError: true,
+		},
+		{
+			name:        "No Items",
+			expectError: true,
+		},
+	}
+
+	server := NewServer(newTestService())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reply, err := server.WeightedChoice(context.Background(), &pb.WeightedChoiceRequest{
+				SeedNum: 42,
+				Items:   tt.items,
+				Count:   5,
+			})
+			if tt.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"a", "a", "a", "a", "a"}, reply.Items)
+			assert.Equal(t, []int64{0, 0, 0, 0, 0}, reply.Indices)
+		})
+	}
+}
+
+func TestRandomServer_Roll(t *testing.T) {
+	randClient := newTestClient(t)
+	ctx := context.Background()
+
+	reply, err := randClient.Roll(ctx, &pb.RollRequest{SeedNum: 42, Dice: "4d6kh3+2"})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Dice, 4)
+	again, err := randClient.Roll(ctx, &pb.RollRequest{SeedNum: 42, Dice: "4d6kh3+2"})
+	assert.NoError(t, err)
+	assert.Equal(t, reply.Total, again.Total, "Expected the same seed to roll the same dice")
+
+	_, err = randClient.Roll(ctx, &pb.RollRequest{SeedNum: 42, Dice: "4d6kh5"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = randClient.Roll(ctx, &pb.RollRequest{SeedNum: 42})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected protovalidate errors to be invalid arguments")
+}
+
+func TestRandomServer_Draw(t *testing.T) {
+	randClient := newTestClient(t)
+	ctx := context.Background()
+
+	created, err := randClient.CreateCommitment(ctx, &pb.CreateCommitmentRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, created.Commitment, 64)
+
+	draw, err := randClient.Draw(ctx, &pb.DrawRequest{
+		CommitmentID: created.CommitmentID,
+		ClientSeed:   "player-42",
+		Range:        &pb.IntRange{Min: 1, Max: 100},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, created.Commitment, draw.Commitment)
+
+	secret, err := hex.DecodeString(draw.Secret)
+	assert.NoError(t, err)
+	assert.Equal(t, created.Commitment, fair.Commit(secret), "Expected the revealed secret to match the commitment")
+	assert.Equal(t, fair.Number(secret, "player-42", 1, 100), draw.Number)
+
+	_, err = randClient.Draw(ctx, &pb.DrawRequest{CommitmentID: created.CommitmentID, ClientSeed: "player-42"})
+	assert.Equal(t, codes.NotFound, status.Code(err), "Expected a used commitment to be reported as not found")
+
+	_, err = randClient.Draw(ctx, &pb.DrawRequest{CommitmentID: created.CommitmentID})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected a client seed to be required")
+}
+
+func newTestSigner(t *testing.T) *signing.Signer {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	assert.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "signing.pem")
+	assert.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600))
+
+	signer, err := signing.NewSigner(&config.Signing{
+		ActiveKeyID: "test",
+		Keys:        []config.SigningKey{{ID: "test", PrivateKeyFile: path}},
+	})
+	assert.NoError(t, err)
+
+	return signer
+}
+
+func TestRandomServer_Signature(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	unsigned, err := newTestServiceClient(t, service).GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 42})
+	assert.NoError(t, err)
+	assert.Nil(t, unsigned.Signature, "Expected outputs not to be signed without an active key")
+	_, err = newTestServiceClient(t, service).GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	service.signer = newTestSigner(t)
+	server := NewServer(service)
+
+	key, err := server.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", key.KeyID)
+	assert.True(t, key.Active)
+
+	for _, mode := range []pb.Mode{pb.Mode_MODE_SEEDED, pb.Mode_MODE_SECURE} {
+		seed := int64(42)
+		if mode == pb.Mode_MODE_SECURE {
+			seed = 0
+		}
+		reply, err := server.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: seed, Mode: mode})
+		assert.NoError(t, err)
+		assert.NoError(t, client.VerifyRandNumber(key.PublicKey, seed, reply), "Expected the %s reply to verify", mode)
+
+		reply.Number++
+		assert.Error(t, client.VerifyRandNumber(key.PublicKey, seed, reply), "Expected an altered number to fail verification")
+	}
+
+	for _, request := range []*pb.GetRandNumberInRangeRequest{
+		{SeedNum: 42, Range: &pb.GetRandNumberInRangeRequest_IntRange{IntRange: &pb.IntRange{Min: 1, Max: 6}}},
+		{SeedNum: 42, Range: &pb.GetRandNumberInRangeRequest_FloatRange{FloatRange: &pb.FloatRange{Min: 0, Max: 1.5}}},
+	} {
+		reply, err := server.GetRandNumberInRange(ctx, request)
+		assert.NoError(t, err)
+		assert.NoError(t, client.VerifyRandNumberInRange(key.PublicKey, request, reply))
+
+		request.SeedNum++
+		assert.Error(t, client.VerifyRandNumberInRange(key.PublicKey, request, reply), "Expected a different seed to fail verification")
+	}
+}
+
+// newTestClient serves the random service in memory, behind the stream
+// interceptors and those of opts, for tests of streaming RPCs.
+func newTestClient(t *testing.T, opts ...grpc.ServerOption) pb.RandomServiceClient {
+	return newTestServiceClient(t, newTestService(), opts...)
+}
+
+// newTestServiceClient returns a client of a server built on service.
+func newTestServiceClient(t *testing.T, service *RandomService, opts ...grpc.ServerOption) pb.RandomServiceClient {
+	in := middleware.NewInterceptor()
+	grpcServer := grpc.NewServer(append([]grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(in.Errors),
+		grpc.ChainStreamInterceptor(in.StreamLogger, in.StreamMetrics, in.StreamErrors),
+	}, opts...)...)
+	pb.RegisterRandomServiceServer(grpcServer, NewServer(service))
+
+	lis := bufconn.Listen(1 << 20)
+	go func() {
+		_ = grpcServer.Serve(lis)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+
+	return pb.NewRandomServiceClient(conn)
+}
+
+func TestRandomServer_DrawSession(t *testing.T) {
+	stream, err := newTestClient(t).DrawSession(context.Background())
+	assert.NoError(t, err)
+
+	commands := []*pb.DrawCommand{
+		{ID: 1, Command: &pb.DrawCommand_Reseed{Reseed: &pb.ReseedCommand{SeedNum: 42, Algorithm: PCG}}},
+		{ID: 2, Command: &pb.DrawCommand_NextInt{NextInt: &pb.NextIntCommand{Count: 2}}},
+		{ID: 3, Command: &pb.DrawCommand_Skip{Skip: &pb.SkipCommand{N: 5}}},
+		{ID: 4, Command: &pb.DrawCommand_NextInRange{NextInRange: &pb.NextInRangeCommand{Range: &pb.IntRange{Min: 1, Max: 6}}}},
+	}
+	var results []*pb.DrawResult
+	for _, command := range commands {
+		assert.NoError(t, stream.Send(command))
+		result, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.Equal(t, command.ID, result.ID)
+		results = append(results, result)
+	}
+
+	assert.Equal(t, PCG, results[0].Algorithm)
+	assert.Len(t, results[1].Numbers, 2)
+	assert.Equal(t, int64(7), results[2].Index)
+	assert.Equal(t, int64(7), results[3].Index)
+	assert.Len(t, results[3].Numbers, 1)
+
+	// Invalid commands end the stream
+	assert.NoError(t, stream.Send(&pb.DrawCommand{ID: 5, Command: &pb.DrawCommand_Skip{Skip: &pb.SkipCommand{N: -1}}}))
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRandomServer_GetRandStrings(t *testing.T) {
+	randClient := newTestClient(t)
+	ctx := context.Background()
+
+	reply, err := randClient.GetRandStrings(ctx, &pb.GetRandStringsRequest{
+		SeedNum: 42,
+		Spec:    &pb.GetRandStringsRequest_Pattern{Pattern: "[A-Z]{3}-[0-9]{4}"},
+		Count:   3,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Strings, 3)
+	assert.Equal(t, Legacy, reply.Algorithm)
+
+	_, err = randClient.GetRandStrings(ctx, &pb.GetRandStringsRequest{
+		SeedNum: 42,
+		Spec:    &pb.GetRandStringsRequest_Pattern{Pattern: "a+"},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected an invalid pattern to be an invalid argument")
+
+	_, err = randClient.GetRandStrings(ctx, &pb.GetRandStringsRequest{SeedNum: 42})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected a pattern or a charset to be required")
+}
+
+func TestRandomServer_GenerateRecords(t *testing.T) {
+	randClient := newTestClient(t)
+	request := &pb.GenerateRecordsRequest{
+		SeedNum: 42,
+		Fields: []*pb.FieldSpec{
+			{Name: "id", Type: &pb.FieldSpec_UUID{UUID: &pb.UUIDType{}}},
+			{Name: "name", Type: &pb.FieldSpec_PersonName{PersonName: &pb.PersonNameType{}}},
+			{Name: "email", Type: &pb.FieldSpec_Email{Email: &pb.EmailType{Domain: "test.local"}}},
+			{Name: "age", Type: &pb.FieldSpec_IntRange{IntRange: &pb.IntRange{Min: 18, Max: 99}}},
+			{Name: "joined", Type: &pb.FieldSpec_DateRange{DateRange: &pb.DateRange{Min: "2024-01-01", Max: "2024-12-31"}}},
+			{Name: "plan", Type: &pb.FieldSpec_Enum{Enum: &pb.EnumType{Values: []string{"free", "pro"}}}},
+		},
+		Count: 50,
+	}
+
+	receive := func(request *pb.GenerateRecordsRequest) ([]*pb.GenerateRecordsReply, error) {
+		stream, err := randClient.GenerateRecords(context.Background(), request)
+		assert.NoError(t, err)
+
+		var replies []*pb.GenerateRecordsReply
+		for {
+			reply, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return replies, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			replies = append(replies, reply)
+		}
+	}
+
+	records, err := receive(request)
+	assert.NoError(t, err)
+	assert.Len(t, records, 50)
+	for i, record := range records {
+		assert.Equal(t, int64(i), record.Index)
+		assert.Len(t, record.Fields, 6)
+		assert.Equal(t, "id", record.Fields[0].Name)
+		assert.Regexp(t, `@test\.local$`, record.Fields[2].GetString_())
+		assert.GreaterOrEqual(t, record.Fields[3].GetInt(), int64(18))
+		assert.Regexp(t, `^2024-`, record.Fields[4].GetString_())
+	}
+
+	request.Count = 10
+	prefix, err := receive(request)
+	assert.NoError(t, err)
+	for i := range prefix {
+		assert.True(t, proto.Equal(records[i], prefix[i]), "Expected the first records not to depend on the count")
+	}
+
+	request.Fields = append(request.Fields, &pb.FieldSpec{Name: "age", Type: &pb.FieldSpec_UUID{UUID: &pb.UUIDType{}}})
+	_, err = receive(request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "Expected field names to be unique")
+}
+
+func TestRandomServer_Jobs(t *testing.T) {
+	randClient := newTestClient(t)
+	ctx := context.Background()
+
+	request := &pb.SubmitJobRequest{
+		SeedNum: 42,
+		Samples: 1000,
+		Estimate: &pb.SubmitJobRequest_Integral{Integral: &pb.IntegralEstimate{
+			Expression: "x^2",
+			Min:        0,
+			Max:        1,
+		}},
+	}
+	submitted, err := randClient.SubmitJob(ctx, request)
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobState_JOB_STATE_QUEUED, submitted.Job.State)
+	assert.True(t, proto.Equal(request, submitted.Job.Request), "Expected the job to echo its request")
+	assert.Equal(t, Legacy, submitted.Job.Algorithm)
+	assert.NotZero(t, submitted.Job.CreatedAt)
+	assert.Zero(t, submitted.Job.StartedAt)
+
+	got, err := randClient.GetJob(ctx, &pb.GetJobRequest{JobID: submitted.Job.JobID})
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(submitted.Job, got.Job))
+
+	cancelled, err := randClient.CancelJob(ctx, &pb.CancelJobRequest{JobID: submitted.Job.JobID})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.JobState_JOB_STATE_CANCELLED, cancelled.Job.State)
+
+	list, err := randClient.ListJobs(ctx, &pb.ListJobsRequest{State: pb.JobState_JOB_STATE_CANCELLED})
+	assert.NoError(t, err)
+	assert.Len(t, list.Jobs, 1)
+	list, err = randClient.ListJobs(ctx, &pb.ListJobsRequest{State: pb.JobState_JOB_STATE_RUNNING})
+	assert.NoError(t, err)
+	assert.Empty(t, list.Jobs)
+
+	_, err = randClient.GetJob(ctx, &pb.GetJobRequest{JobID: "7d444840-9dc0-11d1-b245-5ffdce74fad2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	request.Estimate = &pb.SubmitJobRequest_Integral{Integral: &pb.IntegralEstimate{Expression: "x^2", Min: 1, Max: 0}}
+	_, err = randClient.SubmitJob(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	request.Estimate = &pb.SubmitJobRequest_Integral{Integral: &pb.IntegralEstimate{Expression: "2x", Min: 0, Max: 1}}
+	_, err = randClient.SubmitJob(ctx, request)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRandomServer_ListDraws(t *testing.T) {
+	ctx := context.Background()
+
+	_, err := newTestClient(t).ListDraws(ctx, &pb.ListDrawsRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expected listing to fail without a journal")
+
+	service := newTestService()
+	repo, start := newTestDrawRepository(t)
+	service.draws = repo
+	saveTestDraws(t, repo, start, 5)
+	randClient := newTestServiceClient(t, service)
+
+	reply, err := randClient.ListDraws(ctx, &pb.ListDrawsRequest{
+		From:     start.Add(time.Second).UnixNano(),
+		ClientIP: "192.0.2.1",
+		PageSize: 1,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Draws, 1)
+	assert.NotEmpty(t, reply.NextPageToken)
+	draw := reply.Draws[0]
+	assert.Equal(t, uint64(3), draw.Sequence)
+	assert.Equal(t, start.Add(2*time.Second).UnixNano(), draw.Timestamp)
+	assert.Equal(t, "request-2", draw.RequestID)
+	assert.Equal(t, "192.0.2.1", draw.ClientIP)
+	assert.Equal(t, int64(2), draw.SeedNum)
+	assert.Equal(t, PCG, draw.Algorithm)
+	assert.Equal(t, "1", draw.AlgorithmVersion)
+	assert.JSONEq(t, `{"Number":"2"}`, draw.Output)
+
+	reply, err = randClient.ListDraws(ctx, &pb.ListDrawsRequest{
+		From:      start.Add(time.Second).UnixNano(),
+		ClientIP:  "192.0.2.1",
+		PageToken: reply.NextPageToken,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, reply.Draws, 1)
+	assert.Equal(t, uint64(5), reply.Draws[0].Sequence)
+	assert.Empty(t, reply.NextPageToken)
+
+	for _, request := range []*pb.ListDrawsRequest{
+		{From: 2, To: 1},
+		{PageSize: 1001},
+		{PageToken: "not a cursor"},
+	} {
+		_, err = randClient.ListDraws(ctx, request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "request %v", request)
+	}
+}
+
+func TestRandomServer_ExportDraws(t *testing.T) {
+	ctx := context.Background()
+	service := newTestService()
+	repo, start := newTestDrawRepository(t)
+	service.draws = repo
+	// Enough draws for several chunks
+	saveTestDraws(t, repo, start, 600)
+	randClient := newTestServiceClient(t, service)
+
+	export := func(request *pb.ExportDrawsRequest) ([]byte, int, error) {
+		stream, err := randClient.ExportDraws(ctx, request)
+		assert.NoError(t, err)
+		var file []byte
+		chunks := 0
+		for {
+			reply, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return file, chunks, nil
+			}
+			if err != nil {
+				return nil, chunks, err
+			}
+			assert.LessOrEqual(t, len(reply.Chunk), exportChunkSize)
+			file = append(file, reply.Chunk...)
+			chunks++
+		}
+	}
+
+	file, chunks, err := export(&pb.ExportDrawsRequest{Format: pb.ExportFormat_EXPORT_FORMAT_CSV})
+	assert.NoError(t, err)
+	assert.Greater(t, chunks, 1)
+	records, err := csv.NewReader(bytes.NewReader(file)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 601)
+	assert.Equal(t, exportColumns, records[0])
+	assert.Equal(t, "1", records[1][0])
+	assert.Equal(t, "600", records[600][0])
+
+	file, _, err = export(&pb.ExportDrawsRequest{
+		From:   start.Add(10 * time.Second).UnixNano(),
+		To:     start.Add(12 * time.Second).UnixNano(),
+		Format: pb.ExportFormat_EXPORT_FORMAT_NDJSON,
+	})
+	assert.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(file), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	var draw map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[0]), &draw))
+	assert.Equal(t, float64(11), draw["sequence"])
+	assert.Equal(t, map[string]any{"Number": "10"}, draw["output"])
+
+	_, _, err = export(&pb.ExportDrawsRequest{From: 2, To: 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	stream, err := newTestClient(t).ExportDraws(ctx, &pb.ExportDrawsRequest{})
+	assert.NoError(t, err)
+	_, err = stream.Recv()
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err), "Expected exporting to fail without a journal")
+}
@@ -0,0 +1,201 @@
+package random
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/log"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// NewCache returns the cache of config: Redis backed by memory when a Redis
+// address is set, memory only otherwise.
+func NewCache(config *config.Cache) entity.INumberCache {
+	memory := NewMemoryCache(config.TTL, config.MaxEntries)
+	if config.RedisAddr == "" {
+		return memory
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Addr:         config.RedisAddr,
+		Password:     config.RedisPassword,
+		DB:           config.RedisDB,
+		DialTimeout:  config.RedisTimeout,
+		ReadTimeout:  config.RedisTimeout,
+		WriteTimeout: config.RedisTimeout,
+	})
+
+	return NewFallbackCache(NewRedisCache(client, config.TTL), memory)
+}
+
+// RedisCache stores numbers in Redis, where they expire after ttl.
+type RedisCache struct {
+	client redis.UniversalClient
+	ttl    time.Duration
+}
+
+func NewRedisCache(client redis.UniversalClient, ttl time.Duration) *RedisCache {
+	return &RedisCache{
+		client: client,
+		ttl:    ttl,
+	}
+}
+
+func (c *RedisCache) Get(ctx context.Context, key string) (int64, bool, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Cache.RedisGet")
+	defer tracer.EndSpan(ctx)
+
+	number, err := c.client.Get(ctx, key).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return number, true, nil
+}
+
+func (c *RedisCache) Set(ctx context.Context, key string, number int64) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Cache.RedisSet")
+	defer tracer.EndSpan(ctx)
+
+	return c.client.Set(ctx, key, number, c.ttl).Err()
+}
+
+// MemoryCache keeps at most maxEntries numbers in memory for ttl. All
+// entries live for the same ttl, so they expire in the order they were set
+// and the oldest entry makes room for new ones when full.
+type MemoryCache struct {
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu sync.Mutex
+	// entries holds *memoryEntry from the oldest to the newest
+	entries *list.List
+	keys    map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key     string
+	number  int64
+	expires time.Time
+}
+
+func NewMemoryCache(ttl time.Duration, maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    list.New(),
+		keys:       make(map[string]*list.Element),
+	}
+}
+
+func (c *MemoryCache) Get(ctx context.Context, key string) (int64, bool, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Cache.MemoryGet")
+	defer tracer.EndSpan(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.keys[key]
+	if !ok {
+		return 0, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(element)
+		return 0, false, nil
+	}
+
+	return entry.number, true, nil
+}
+
+func (c *MemoryCache) Set(ctx context.Context, key string, number int64) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Cache.MemorySet")
+	defer tracer.EndSpan(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if element, ok := c.keys[key]; ok {
+		c.remove(element)
+	}
+	for element := c.entries.Front(); element != nil; element = c.entries.Front() {
+		if c.entries.Len() < c.maxEntries && now.Before(element.Value.(*memoryEntry).expires) {
+			break
+		}
+		c.remove(element)
+	}
+	c.keys[key] = c.entries.PushBack(&memoryEntry{
+		key:     key,
+		number:  number,
+		expires: now.Add(c.ttl),
+	})
+
+	return nil
+}
+
+// remove drops element, c.mu must be held.
+func (c *MemoryCache) remove(element *list.Element) {
+	c.entries.Remove(element)
+	delete(c.keys, element.Value.(*memoryEntry).key)
+}
+
+// FallbackCache serves from primary, and from fallback whenever primary
+// fails. It never fails itself as long as fallback does not.
+type FallbackCache struct {
+	primary  entity.INumberCache
+	fallback entity.INumberCache
+}
+
+func NewFallbackCache(primary, fallback entity.INumberCache) *FallbackCache {
+	return &FallbackCache{
+		primary:  primary,
+		fallback: fallback,
+	}
+}
+
+func (c *FallbackCache) Get(ctx context.Context, key string) (int64, bool, error) {
+	number, ok, err := c.primary.Get(ctx, key)
+	if err == nil {
+		return number, ok, nil
+	}
+	c.fellBack("get", err)
+
+	return c.fallback.Get(ctx, key)
+}
+
+func (c *FallbackCache) Set(ctx context.Context, key string, number int64) error {
+	err := c.primary.Set(ctx, key, number)
+	if err == nil {
+		return nil
+	}
+	c.fellBack("set", err)
+
+	return c.fallback.Set(ctx, key, number)
+}
+
+func (c *FallbackCache) fellBack(operation string, err error) {
+	log.GetLogger().Debugf("cache %s falling back to memory: %v", operation, err)
+
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(metric.Random_cache_fallbacks_total.Name) {
+		_ = metr.Counter(metric.Random_cache_fallbacks_total, 1)
+	}
+}
@@ -0,0 +1,60 @@
+package random
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// CacheRepo caches the numbers Get returns, which only depend on the seed
+// and the algorithm, and passes the other methods through. Cache failures
+// are not errors: the number is generated again.
+type CacheRepo struct {
+	entity.IRandomRepository
+	cache entity.INumberCache
+}
+
+func NewCacheRepository(repo entity.IRandomRepository, cache entity.INumberCache) *CacheRepo {
+	return &CacheRepo{
+		IRandomRepository: repo,
+		cache:             cache,
+	}
+}
+
+func (r *CacheRepo) Get(ctx context.Context, seed int64) (entity.Random, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CachedGetRandNumber")
+	defer tracer.EndSpan(ctx)
+
+	algorithm := r.Algorithm()
+	key := cacheKey(algorithm, seed)
+	if number, ok, err := r.cache.Get(ctx, key); err == nil && ok {
+		r.count(metric.Random_cache_hits_total, algorithm)
+		return entity.Random{Number: number}, nil
+	}
+	r.count(metric.Random_cache_misses_total, algorithm)
+
+	randNum, err := r.IRandomRepository.Get(ctx, seed)
+	if err != nil {
+		return entity.Random{}, err
+	}
+	_ = r.cache.Set(ctx, key, randNum.Number)
+
+	return randNum, nil
+}
+
+func (r *CacheRepo) count(m *metric.Metric, algorithm entity.Algorithm) {
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(m.Name) {
+		_ = metr.Counter(m, 1, algorithm.Name)
+	}
+}
+
+// cacheKey is the key of the number of seed, the version keeps numbers of an
+// older version of the algorithm from being served.
+func cacheKey(algorithm entity.Algorithm, seed int64) string {
+	return fmt.Sprintf("soa-404:random:%s:%s:%d", algorithm.Name, algorithm.Version, seed)
+}
@@ -0,0 +1,101 @@
+package random
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+// countingRepo counts the numbers its repository generates.
+type countingRepo struct {
+	*RandomRepo
+	gets int
+}
+
+func (r *countingRepo) Get(ctx context.Context, seed int64) (entity.Random, error) {
+	r.gets++
+	return r.RandomRepo.Get(ctx, seed)
+}
+
+func TestCacheRepo_Get(t *testing.T) {
+	server := miniredis.RunT(t)
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		name  string
+		cache entity.INumberCache
+		gets  int
+	}{
+		{
+			name:  "Memory",
+			cache: NewMemoryCache(time.Minute, 10),
+			gets:  2,
+		},
+		{
+			name: "Redis",
+			cache: NewCache(&config.Cache{
+				RedisAddr:    server.Addr(),
+				RedisTimeout: time.Second,
+				TTL:          time.Minute,
+				MaxEntries:   10,
+			}),
+			gets: 2,
+		},
+		{
+			name:  "Failing cache",
+			cache: failingCache{},
+			gets:  4,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			server.FlushAll()
+			underlying := &countingRepo{RandomRepo: newRepository(algorithms[PCG])}
+			repo := NewCacheRepository(underlying, tc.cache)
+
+			for _, seed := range []int64{2, 3, 2, 3} {
+				want, _ := underlying.RandomRepo.Get(ctx, seed)
+				got, err := repo.Get(ctx, seed)
+				assert.NoError(t, err)
+				assert.Equal(t, want, got, "Expected cached numbers to match generated ones")
+			}
+			assert.Equal(t, tc.gets, underlying.gets)
+			assert.Equal(t, underlying.Algorithm(), repo.Algorithm())
+		})
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	pcg := algorithms[PCG].Algorithm
+	assert.Equal(t, "soa-404:random:pcg:1:42", cacheKey(pcg, 42))
+
+	// Other versions of an algorithm do not share numbers
+	next := pcg
+	next.Version = "2"
+	assert.NotEqual(t, cacheKey(pcg, 42), cacheKey(next, 42))
+	assert.NotEqual(t, cacheKey(pcg, 42), cacheKey(algorithms[Legacy].Algorithm, 42))
+}
+
+func TestRegistry_UseCache(t *testing.T) {
+	registry, err := NewRegistry(&config.Random{DefaultAlgorithm: Legacy})
+	assert.NoError(t, err)
+
+	repo, err := registry.Get("")
+	assert.NoError(t, err)
+	assert.IsType(t, &RandomRepo{}, repo)
+
+	registry.UseCache(NewMemoryCache(time.Minute, 10))
+	for _, name := range registry.Names() {
+		repo, err := registry.Get(name)
+		assert.NoError(t, err)
+		assert.IsType(t, &CacheRepo{}, repo)
+		assert.Equal(t, name, repo.Algorithm().Name)
+	}
+	_, err = registry.Get("unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,168 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+func newTestRedisCache(t *testing.T) (*RedisCache, *miniredis.Miniredis) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewRedisCache(client, time.Minute), server
+}
+
+func newTestMemoryCache(maxEntries int) (*MemoryCache, *time.Time) {
+	now := time.Unix(0, 0)
+	cache := NewMemoryCache(time.Minute, maxEntries)
+	cache.now = func() time.Time {
+		return now
+	}
+
+	return cache, &now
+}
+
+func TestRedisCache(t *testing.T) {
+	cache, server := newTestRedisCache(t)
+	ctx := context.Background()
+
+	_, ok, err := cache.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Set(ctx, "a", -42))
+	number, ok, err := cache.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(-42), number)
+	assert.Equal(t, time.Minute, server.TTL("a"))
+
+	server.FastForward(time.Minute)
+	_, ok, err = cache.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, ok, "Expected the entry to expire after the TTL")
+
+	assert.NoError(t, server.Set("b", "not a number"))
+	_, _, err = cache.Get(ctx, "b")
+	assert.Error(t, err)
+
+	server.Close()
+	_, _, err = cache.Get(ctx, "a")
+	assert.Error(t, err)
+	assert.Error(t, cache.Set(ctx, "a", 1))
+}
+
+func TestMemoryCache(t *testing.T) {
+	cache, now := newTestMemoryCache(2)
+	ctx := context.Background()
+
+	_, ok, _ := cache.Get(ctx, "a")
+	assert.False(t, ok)
+
+	assert.NoError(t, cache.Set(ctx, "a", 1))
+	*now = now.Add(time.Second)
+	assert.NoError(t, cache.Set(ctx, "b", 2))
+	number, ok, _ := cache.Get(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, int64(1), number)
+
+	// The oldest entry makes room when full
+	assert.NoError(t, cache.Set(ctx, "c", 3))
+	_, ok, _ = cache.Get(ctx, "a")
+	assert.False(t, ok)
+	assert.Len(t, cache.keys, 2)
+
+	// Setting a key again renews it
+	assert.NoError(t, cache.Set(ctx, "b", 2))
+	assert.NoError(t, cache.Set(ctx, "d", 4))
+	_, ok, _ = cache.Get(ctx, "b")
+	assert.True(t, ok)
+	_, ok, _ = cache.Get(ctx, "c")
+	assert.False(t, ok)
+
+	*now = now.Add(time.Minute)
+	for _, key := range []string{"b", "d"} {
+		_, ok, _ = cache.Get(ctx, key)
+		assert.False(t, ok, "Expected %s to expire after the TTL", key)
+	}
+	assert.Zero(t, cache.entries.Len())
+	assert.Empty(t, cache.keys)
+}
+
+func TestFallbackCache(t *testing.T) {
+	primary, server := newTestRedisCache(t)
+	fallback, _ := newTestMemoryCache(10)
+	cache := NewFallbackCache(primary, fallback)
+	ctx := context.Background()
+
+	assert.NoError(t, cache.Set(ctx, "a", 1))
+	assert.True(t, server.Exists("a"))
+	_, ok, _ := fallback.Get(ctx, "a")
+	assert.False(t, ok, "Expected memory to be used only when Redis fails")
+
+	server.Close()
+	_, ok, err := cache.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, cache.Set(ctx, "b", 2))
+	number, ok, err := cache.Get(ctx, "b")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, int64(2), number)
+}
+
+func TestNewCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	for _, tc := range []struct {
+		name   string
+		config config.Cache
+		assert func(t *testing.T, cache interface{})
+	}{
+		{
+			name:   "Memory only without a Redis address",
+			config: config.Cache{TTL: time.Minute, MaxEntries: 10, RedisTimeout: time.Second},
+			assert: func(t *testing.T, cache interface{}) {
+				assert.IsType(t, &MemoryCache{}, cache)
+			},
+		},
+		{
+			name:   "Redis backed by memory",
+			config: config.Cache{TTL: time.Minute, MaxEntries: 10, RedisTimeout: time.Second, RedisAddr: server.Addr()},
+			assert: func(t *testing.T, cache interface{}) {
+				assert.IsType(t, &FallbackCache{}, cache)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := NewCache(&tc.config)
+			tc.assert(t, cache)
+
+			ctx := context.Background()
+			assert.NoError(t, cache.Set(ctx, "a", 1))
+			number, ok, err := cache.Get(ctx, "a")
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, int64(1), number)
+		})
+	}
+}
+
+// failingCache fails every operation.
+type failingCache struct{}
+
+func (failingCache) Get(context.Context, string) (int64, bool, error) {
+	return 0, false, errors.New("unavailable")
+}
+
+func (failingCache) Set(context.Context, string, int64) error {
+	return errors.New("unavailable")
+}
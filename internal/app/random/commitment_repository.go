@@ -0,0 +1,102 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// CommitmentRepo keeps the secrets of provably fair draws in memory until
+// they are drawn from. At most MaxCommitments are pending at once.
+//
+// Expired commitments are kept for another TTL so that late draws fail as
+// expired rather than unknown.
+type CommitmentRepo struct {
+	config *config.Commitments
+	now    func() time.Time
+
+	mu          sync.Mutex
+	commitments map[string]entity.Commitment
+}
+
+func NewCommitmentRepository(config *config.Commitments) *CommitmentRepo {
+	return &CommitmentRepo{
+		config:      config,
+		now:         time.Now,
+		commitments: make(map[string]entity.Commitment),
+	}
+}
+
+func (r *CommitmentRepo) Create(ctx context.Context, commitment entity.Commitment) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CreateCommitment")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Expired commitments must not hold slots until the next sweep
+	if len(r.commitments) >= r.config.MaxCommitments {
+		r.evict(0)
+	}
+	if len(r.commitments) >= r.config.MaxCommitments {
+		return fmt.Errorf("%d commitments are pending, draw from some before creating more: %w", len(r.commitments), grpc_errors.ErrExhausted)
+	}
+	r.commitments[commitment.ID] = commitment
+
+	return nil
+}
+
+func (r *CommitmentRepo) Take(ctx context.Context, id string) (entity.Commitment, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.TakeCommitment")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	commitment, ok := r.commitments[id]
+	if !ok {
+		return entity.Commitment{}, fmt.Errorf("commitment %s is unknown or was already drawn from: %w", id, grpc_errors.ErrNotFound)
+	}
+	delete(r.commitments, id)
+
+	if !r.now().Before(commitment.ExpiresAt) {
+		return entity.Commitment{}, fmt.Errorf("commitment %s expired at %s: %w", id, commitment.ExpiresAt.UTC().Format(time.RFC3339), grpc_errors.ErrExpired)
+	}
+
+	return commitment, nil
+}
+
+// Run removes long expired commitments periodically until stopCh is closed.
+func (r *CommitmentRepo) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(min(r.config.TTL, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.evict(r.config.TTL)
+			r.mu.Unlock()
+		}
+	}
+}
+
+// evict removes the commitments expired for grace or longer, r.mu must be held.
+func (r *CommitmentRepo) evict(grace time.Duration) {
+	now := r.now()
+	for id, commitment := range r.commitments {
+		if !now.Before(commitment.ExpiresAt.Add(grace)) {
+			delete(r.commitments, id)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package random
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+func newTestCommitmentRepository(maxCommitments int) (*CommitmentRepo, *time.Time) {
+	now := time.Unix(0, 0)
+	repo := NewCommitmentRepository(&config.Commitments{
+		TTL:            time.Minute,
+		MaxCommitments: maxCommitments,
+	})
+	repo.now = func() time.Time {
+		return now
+	}
+
+	return repo, &now
+}
+
+func TestCommitmentRepo_TakeOnce(t *testing.T) {
+	repo, now := newTestCommitmentRepository(10)
+	ctx := context.Background()
+
+	commitment := entity.Commitment{ID: "a", Hash: "hash", Secret: []byte("secret"), ExpiresAt: now.Add(time.Minute)}
+	assert.NoError(t, repo.Create(ctx, commitment))
+
+	taken, err := repo.Take(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, commitment, taken)
+
+	_, err = repo.Take(ctx, "a")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound, "Expected a commitment to back a single draw")
+	_, err = repo.Take(ctx, "b")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+}
+
+func TestCommitmentRepo_Expiry(t *testing.T) {
+	repo, now := newTestCommitmentRepository(10)
+	ctx := context.Background()
+
+	for _, id := range []string{"a", "b"} {
+		assert.NoError(t, repo.Create(ctx, entity.Commitment{ID: id, ExpiresAt: now.Add(time.Minute)}))
+	}
+
+	*now = now.Add(time.Minute)
+	_, err := repo.Take(ctx, "a")
+	assert.ErrorIs(t, err, grpc_errors.ErrExpired)
+
+	// Sweeps forget commitments expired for longer than the TTL
+	*now = now.Add(time.Minute + time.Second)
+	repo.evict(repo.config.TTL)
+	assert.Empty(t, repo.commitments)
+	_, err = repo.Take(ctx, "b")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+}
+
+func TestCommitmentRepo_MaxCommitments(t *testing.T) {
+	repo, now := newTestCommitmentRepository(2)
+	ctx := context.Background()
+
+	for _, id := range []string{"a", "b"} {
+		assert.NoError(t, repo.Create(ctx, entity.Commitment{ID: id, ExpiresAt: now.Add(time.Minute)}))
+	}
+	err := repo.Create(ctx, entity.Commitment{ID: "c", ExpiresAt: now.Add(time.Minute)})
+	assert.ErrorIs(t, err, grpc_errors.ErrExhausted)
+
+	// Expired commitments free their slot
+	*now = now.Add(time.Minute)
+	assert.NoError(t, repo.Create(ctx, entity.Commitment{ID: "c", ExpiresAt: now.Add(time.Minute)}))
+	assert.Len(t, repo.commitments, 1)
+}
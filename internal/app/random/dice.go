@@ -0,0 +1,227 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+const (
+	maxDiceTerms    = 10
+	maxDiceCount    = 100
+	maxDiceSides    = 1000000
+	maxDiceModifier = 1000000
+	// maxExplosions bounds the extra dice an exploding term rolls.
+	maxExplosions = 100
+)
+
+// parseDice parses dice notation, letters are case-insensitive and spaces
+// between tokens are ignored:
+//
+//	expression = ["+" | "-"] term {("+" | "-") term}
+//	term       = number | [number] "d" (number | "%") ["!"] [keep number]
+//	keep       = "kh" | "k" | "kl" | "dh" | "dl"
+//
+// "!" explodes the dice, kh/k and kl keep the highest or lowest dice,
+// dh and dl drop them.
+func parseDice(notation string) (entity.DiceExpression, error) {
+	p := diceParser{notation: notation, s: strings.ToLower(notation)}
+	return p.parse()
+}
+
+type diceParser struct {
+	notation string
+	// s is the lower-cased notation
+	s   string
+	pos int
+}
+
+func (p *diceParser) parse() (entity.DiceExpression, error) {
+	var expr entity.DiceExpression
+	if strings.TrimSpace(p.s) == "" {
+		return expr, errors.New("validate: dice notation is empty")
+	}
+
+	negative := false
+	switch p.peek() {
+	case '-':
+		negative = true
+		p.pos++
+	case '+':
+		p.pos++
+	}
+
+	for {
+		if err := p.term(&expr, negative); err != nil {
+			return expr, err
+		}
+
+		switch p.peek() {
+		case 0:
+			if len(expr.Dice) == 0 {
+				return expr, fmt.Errorf("validate: dice notation %q has no dice", p.notation)
+			}
+			return expr, nil
+		case '+':
+			negative = false
+		case '-':
+			negative = true
+		default:
+			return expr, p.errorf("expected + or -")
+		}
+		p.pos++
+	}
+}
+
+func (p *diceParser) term(expr *entity.DiceExpression, negative bool) error {
+	count, hasCount, err := p.number()
+	if err != nil {
+		return err
+	}
+
+	if p.peek() != 'd' {
+		if !hasCount {
+			return p.errorf("expected a number or a die")
+		}
+		if negative {
+			count = -count
+		}
+		expr.Modifier += count
+		if expr.Modifier < -maxDiceModifier || expr.Modifier > maxDiceModifier {
+			return fmt.Errorf("validate: modifiers must add up to between %d and %d", -maxDiceModifier, maxDiceModifier)
+		}
+		return nil
+	}
+	p.pos++
+
+	dice := entity.Dice{Count: 1, Negative: negative}
+	if hasCount {
+		if count < 1 || count > maxDiceCount {
+			return fmt.Errorf("validate: a term rolls between 1 and %d dice", maxDiceCount)
+		}
+		dice.Count = int(count)
+	}
+
+	if p.peek() == '%' {
+		p.pos++
+		dice.Sides = 100
+	} else {
+		sides, ok, err := p.number()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return p.errorf("expected the number of sides")
+		}
+		if sides < 1 || sides > maxDiceSides {
+			return fmt.Errorf("validate: dice have between 1 and %d sides", maxDiceSides)
+		}
+		dice.Sides = sides
+	}
+
+	if p.peek() == '!' {
+		p.pos++
+		if dice.Sides < 2 {
+			return errors.New("validate: dice with a single side cannot explode")
+		}
+		dice.Explode = true
+	}
+
+	if err := p.keep(&dice); err != nil {
+		return err
+	}
+
+	if len(expr.Dice) == maxDiceTerms {
+		return fmt.Errorf("validate: dice notation has more than %d dice terms", maxDiceTerms)
+	}
+	expr.Dice = append(expr.Dice, dice)
+
+	return nil
+}
+
+// keep parses the optional keep or drop suffix of a term.
+func (p *diceParser) keep(dice *entity.Dice) error {
+	p.skipSpaces()
+
+	var op string
+	for _, candidate := range []string{"kh", "kl", "dh", "dl", "k"} {
+		if strings.HasPrefix(p.s[p.pos:], candidate) {
+			op = candidate
+			break
+		}
+	}
+	if op == "" {
+		return nil
+	}
+	p.pos += len(op)
+
+	n, ok, err := p.number()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return p.errorf("expected a number of dice")
+	}
+
+	switch op {
+	case "kh", "k", "kl":
+		if n < 1 || n > int64(dice.Count) {
+			return fmt.Errorf("validate: cannot keep %d of %d dice", n, dice.Count)
+		}
+		dice.Keep = int(n)
+		dice.KeepLowest = op == "kl"
+	case "dh", "dl":
+		if n < 0 || n >= int64(dice.Count) {
+			return fmt.Errorf("validate: cannot drop %d of %d dice", n, dice.Count)
+		}
+		// Dropping the highest dice keeps the lowest ones and the other way around
+		dice.Keep = dice.Count - int(n)
+		dice.KeepLowest = op == "dh"
+	}
+
+	return nil
+}
+
+// number parses an optional non-negative number.
+func (p *diceParser) number() (int64, bool, error) {
+	p.skipSpaces()
+
+	start := p.pos
+	var n int64
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		n = n*10 + int64(p.s[p.pos]-'0')
+		p.pos++
+		// Every limit is below this, checking here keeps n from overflowing
+		if n > maxDiceModifier {
+			return 0, false, fmt.Errorf("validate: number at position %d of %q is too large", start+1, p.notation)
+		}
+	}
+
+	return n, p.pos > start, nil
+}
+
+// peek returns the next character that is not a space, or 0 at the end.
+func (p *diceParser) peek() byte {
+	p.skipSpaces()
+	if p.pos == len(p.s) {
+		return 0
+	}
+
+	return p.s[p.pos]
+}
+
+func (p *diceParser) skipSpaces() {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+}
+
+func (p *diceParser) errorf(expected string) error {
+	if p.pos == len(p.s) {
+		return fmt.Errorf("validate: %s at the end of %q", expected, p.notation)
+	}
+
+	return fmt.Errorf("validate: %s at position %d of %q", expected, p.pos+1, p.notation)
+}
@@ -0,0 +1,82 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestParseDice(t *testing.T) {
+	tests := []struct {
+		name        string
+		notation    string
+		expect      entity.DiceExpression
+		expectError bool
+	}{
+		{
+			name:     "Dice With Modifier",
+			notation: "3d6+2",
+			expect: entity.DiceExpression{
+				Dice:     []entity.Dice{{Count: 3, Sides: 6}},
+				Modifier: 2,
+			},
+		},
+		{
+			name:     "Keep Highest",
+			notation: "4d6kh3",
+			expect: entity.DiceExpression{
+				Dice: []entity.Dice{{Count: 4, Sides: 6, Keep: 3}},
+			},
+		},
+		{
+			name:     "Keep Lowest And Single Die",
+			notation: "2d20kl1 + d4",
+			expect: entity.DiceExpression{
+				Dice: []entity.Dice{{Count: 2, Sides: 20, Keep: 1, KeepLowest: true}, {Count: 1, Sides: 4}},
+			},
+		},
+		{
+			name:     "Drop Lowest",
+			notation: "4D6DL1",
+			expect: entity.DiceExpression{
+				Dice: []entity.Dice{{Count: 4, Sides: 6, Keep: 3}},
+			},
+		},
+		{
+			name:     "Exploding Percentile And Negative Terms",
+			notation: "-1+2d%!-1d8-3",
+			expect: entity.DiceExpression{
+				Dice:     []entity.Dice{{Count: 2, Sides: 100, Explode: true}, {Count: 1, Sides: 8, Negative: true}},
+				Modifier: -4,
+			},
+		},
+		{name: "Empty", notation: " ", expectError: true},
+		{name: "No Dice", notation: "1+2", expectError: true},
+		{name: "Missing Sides", notation: "3d", expectError: true},
+		{name: "Zero Dice", notation: "0d6", expectError: true},
+		{name: "Zero Sides", notation: "1d0", expectError: true},
+		{name: "Keep Too Many", notation: "2d6kh3", expectError: true},
+		{name: "Drop Every Die", notation: "2d6dl2", expectError: true},
+		{name: "Missing Keep Count", notation: "4d6kh", expectError: true},
+		{name: "Single Side Explodes", notation: "3d1!", expectError: true},
+		{name: "Trailing Operator", notation: "1d6+", expectError: true},
+		{name: "Unknown Character", notation: "1d6*2", expectError: true},
+		{name: "Too Many Dice", notation: "101d6", expectError: true},
+		{name: "Huge Number", notation: "1d99999999999999999999", expectError: true},
+		{name: "Too Many Terms", notation: "d4+d4+d4+d4+d4+d4+d4+d4+d4+d4+d4", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := parseDice(tt.notation)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, expr)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+// newSampler returns a function drawing successive samples of dist from rand.
+func newSampler(rand *rand.Rand, dist entity.Distribution) (func() float64, error) {
+	switch dist.Kind {
+	case entity.Normal:
+		return func() float64 {
+			return dist.Mean + dist.StdDev*rand.NormFloat64()
+		}, nil
+	case entity.Exponential:
+		return func() float64 {
+			return rand.ExpFloat64() / dist.Rate
+		}, nil
+	case entity.Poisson:
+		return func() float64 {
+			return float64(poisson(rand, dist.Lambda))
+		}, nil
+	case entity.Binomial:
+		return func() float64 {
+			return float64(binomial(rand, dist.N, dist.P))
+		}, nil
+	case entity.Uniform:
+		bounds := entity.FloatRange{Min: dist.Min, Max: dist.Max}
+		return func() float64 {
+			return floatInRange(rand, bounds)
+		}, nil
+	}
+
+	return nil, fmt.Errorf("validate: unknown distribution %q", dist.Kind)
+}
+
+// poisson draws from Poisson(lambda). Small rates multiply uniforms (Knuth),
+// larger rates use the PTRS transformed rejection of Hörmann (1993), which
+// runs in constant expected time.
+func poisson(rand *rand.Rand, lambda float64) int64 {
+	if lambda < 10 {
+		limit := math.Exp(-lambda)
+		k := int64(0)
+		for p := rand.Float64(); p > limit; p *= rand.Float64() {
+			k++
+		}
+		return k
+	}
+
+	logLambda := math.Log(lambda)
+	b := 0.931 + 2.53*math.Sqrt(lambda)
+	a := -0.059 + 0.02483*b
+	invAlpha := 1.1239 + 1.1328/(b-3.4)
+	vr := 0.9277 - 3.6224/(b-2)
+	for {
+		u := rand.Float64() - 0.5
+		v := rand.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + lambda + 0.43)
+		if us >= 0.07 && v <= vr {
+			return int64(k)
+		}
+		if k < 0 || (us < 0.013 && v > us) {
+			continue
+		}
+
+		lg, _ := math.Lgamma(k + 1)
+		if math.Log(v)+math.Log(invAlpha)-math.Log(a/(us*us)+b) <= -lambda+k*logLambda-lg {
+			return int64(k)
+		}
+	}
+}
+
+// binomial draws from Binomial(n, p). When few successes are expected it
+// counts geometric gaps between successes, otherwise it uses the BTRS
+// transformed rejection of Hörmann (1993).
+func binomial(rand *rand.Rand, n int64, p float64) int64 {
+	switch {
+	case n == 0 || p == 0:
+		return 0
+	case p == 1:
+		return n
+	case p > 0.5:
+		return n - binomial(rand, n, 1-p)
+	case float64(n)*p < 10:
+		return binomialInversion(rand, n, p)
+	}
+
+	return binomialBTRS(rand, n, p)
+}
+
+func binomialInversion(rand *rand.Rand, n int64, p float64) int64 {
+	logQ := math.Log1p(-p)
+	successes := int64(0)
+	trials := 0.0
+	for {
+		// Number of trials up to and including the next success,
+		// 1-Float64 keeps the logarithm finite
+		trials += math.Ceil(math.Log(1-rand.Float64()) / logQ)
+		if trials > float64(n) {
+			return successes
+		}
+		successes++
+	}
+}
+
+func binomialBTRS(rand *rand.Rand, n int64, p float64) int64 {
+	nf := float64(n)
+	stddev := math.Sqrt(nf * p * (1 - p))
+	b := 1.15 + 2.53*stddev
+	a := -0.0873 + 0.0248*b + 0.01*p
+	c := nf*p + 0.5
+	vr := 0.92 - 4.2/b
+	r := p / (1 - p)
+	alpha := (2.83 + 5.1/b) * stddev
+	m := math.Floor((nf + 1) * p)
+	for {
+		u := rand.Float64() - 0.5
+		v := rand.Float64()
+		us := 0.5 - math.Abs(u)
+		k := math.Floor((2*a/us+b)*u + c)
+		if k < 0 || k > nf {
+			continue
+		}
+		if us >= 0.07 && v <= vr {
+			return int64(k)
+		}
+
+		v = math.Log(v * alpha / (a/(us*us) + b))
+		bound := (m+0.5)*math.Log((m+1)/(r*(nf-m+1))) +
+			(nf+1)*math.Log((nf-m+1)/(nf-k+1)) +
+			(k+0.5)*math.Log(r*(nf-k+1)/(k+1)) +
+			stirlingTail(m) + stirlingTail(nf-m) - stirlingTail(k) - stirlingTail(nf-k)
+		if v <= bound {
+			return int64(k)
+		}
+	}
+}
+
+// stirlingTailValues holds log(k!) - Stirling's approximation of log(k!) for k < 10.
+var stirlingTailValues = [...]float64{
+	0.0810614667953272,
+	0.0413406959554092,
+	0.0276779256849983,
+	0.02079067210376509,
+	0.0166446911898211,
+	0.0138761288230707,
+	0.0118967099458917,
+	0.0104112652619720,
+	0.00925546218271273,
+	0.00833056343336287,
+}
+
+// stirlingTail returns the error of Stirling's approximation of log(k!).
+func stirlingTail(k float64) float64 {
+	if k < float64(len(stirlingTailValues)) {
+		return stirlingTailValues[int(k)]
+	}
+
+	kp1sq := (k + 1) * (k + 1)
+	return (1.0/12 - (1.0/360-1.0/1260/kp1sq)/kp1sq) / (k + 1)
+}
@@ -0,0 +1,101 @@
+package random
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestNewSampler_Moments(t *testing.T) {
+	tests := []struct {
+		name         string
+		dist         entity.Distribution
+		expectMean   float64
+		expectStdDev float64
+	}{
+		{
+			name:         "Normal",
+			dist:         entity.Distribution{Kind: entity.Normal, Mean: 10, StdDev: 2},
+			expectMean:   10,
+			expectStdDev: 2,
+		},
+		{
+			name:         "Exponential",
+			dist:         entity.Distribution{Kind: entity.Exponential, Rate: 0.5},
+			expectMean:   2,
+			expectStdDev: 2,
+		},
+		{
+			name:         "Small Poisson",
+			dist:         entity.Distribution{Kind: entity.Poisson, Lambda: 3},
+			expectMean:   3,
+			expectStdDev: math.Sqrt(3),
+		},
+		{
+			name:         "Large Poisson",
+			dist:         entity.Distribution{Kind: entity.Poisson, Lambda: 1000},
+			expectMean:   1000,
+			expectStdDev: math.Sqrt(1000),
+		},
+		{
+			name:         "Small Binomial",
+			dist:         entity.Distribution{Kind: entity.Binomial, N: 20, P: 0.2},
+			expectMean:   4,
+			expectStdDev: math.Sqrt(20 * 0.2 * 0.8),
+		},
+		{
+			name:         "Large Binomial",
+			dist:         entity.Distribution{Kind: entity.Binomial, N: 10000, P: 0.7},
+			expectMean:   7000,
+			expectStdDev: math.Sqrt(10000 * 0.7 * 0.3),
+		},
+		{
+			name:         "Uniform",
+			dist:         entity.Distribution{Kind: entity.Uniform, Min: -1, Max: 1},
+			expectMean:   0,
+			expectStdDev: 2 / math.Sqrt(12),
+		},
+	}
+
+	const n = 50000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sample, err := newSampler(rand.New(rand.NewSource(42)), tt.dist)
+			assert.NoError(t, err)
+
+			var sum, sumSq float64
+			for i := 0; i < n; i++ {
+				v := sample()
+				sum += v
+				sumSq += v * v
+			}
+			mean := sum / n
+			stddev := math.Sqrt(sumSq/n - mean*mean)
+
+			// Allow five standard errors of the mean
+			assert.InDelta(t, tt.expectMean, mean, 5*tt.expectStdDev/math.Sqrt(n), "Unexpected sample mean")
+			assert.InEpsilon(t, tt.expectStdDev, stddev, 0.05, "Unexpected sample standard deviation")
+		})
+	}
+}
+
+func TestBinomial_EdgeCases(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	assert.Equal(t, int64(0), binomial(r, 0, 0.5))
+	assert.Equal(t, int64(0), binomial(r, 100, 0))
+	assert.Equal(t, int64(100), binomial(r, 100, 1))
+	for i := 0; i < 1000; i++ {
+		k := binomial(r, 50, 0.9)
+		assert.True(t, k >= 0 && k <= 50, "Expected a sample within [0, n], got %d", k)
+	}
+}
+
+func TestNewSampler_UnknownDistribution(t *testing.T) {
+	_, err := newSampler(rand.New(rand.NewSource(42)), entity.Distribution{Kind: "cauchy"})
+	assert.ErrorContains(t, err, "validate")
+}
@@ -0,0 +1,127 @@
+package random
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/journal"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// DrawRepo keeps the draw history in the journal file. Listing scans the
+// file from the cursor on, cursors being offsets in the file, so a page
+// costs at most one pass over the draws after the previous page.
+type DrawRepo struct {
+	journal *journal.Journal
+}
+
+func NewDrawRepository(journal *journal.Journal) *DrawRepo {
+	return &DrawRepo{
+		journal: journal,
+	}
+}
+
+func (r *DrawRepo) Save(ctx context.Context, draw entity.Draw) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.SaveDraw")
+	defer tracer.EndSpan(ctx)
+
+	r.journal.Record(journal.Entry{
+		Timestamp:        draw.Timestamp,
+		RequestID:        draw.RequestID,
+		ClientIP:         draw.ClientIP,
+		Method:           draw.Method,
+		Seed:             draw.Seed,
+		Algorithm:        draw.Algorithm.Name,
+		AlgorithmVersion: draw.Algorithm.Version,
+		Output:           draw.Output,
+	})
+
+	return nil
+}
+
+func (r *DrawRepo) List(ctx context.Context, filter entity.DrawFilter, cursor string, limit int) (entity.DrawPage, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.ListDraws")
+	defer tracer.EndSpan(ctx)
+
+	if !r.journal.Enabled() {
+		return entity.DrawPage{}, fmt.Errorf("the draw history is kept in the journal, which is %w", grpc_errors.ErrDisabled)
+	}
+	offset, err := decodeDrawCursor(cursor)
+	if err != nil {
+		return entity.DrawPage{}, err
+	}
+
+	page := entity.DrawPage{Draws: make([]entity.Draw, 0, min(limit, 100))}
+	// end is the offset after the last draw of the page
+	end := offset
+	err = r.journal.Scan(offset, func(entry journal.Entry, next int64) bool {
+		if ctx.Err() != nil {
+			return false
+		}
+
+		draw := drawFromEntry(entry)
+		if !filter.Match(draw) {
+			return true
+		}
+		if len(page.Draws) == limit {
+			page.NextCursor = encodeDrawCursor(end)
+			return false
+		}
+		page.Draws = append(page.Draws, draw)
+		end = next
+
+		return true
+	})
+	if errors.Is(err, journal.ErrOffset) {
+		return entity.DrawPage{}, errors.New("validate: cursor does not belong to this server's draw history")
+	}
+	if err != nil {
+		return entity.DrawPage{}, fmt.Errorf("failed to read the draw history: %w", err)
+	}
+	if err := ctx.Err(); err != nil {
+		return entity.DrawPage{}, err
+	}
+
+	return page, nil
+}
+
+func drawFromEntry(entry journal.Entry) entity.Draw {
+	return entity.Draw{
+		Sequence:  entry.Sequence,
+		Timestamp: entry.Timestamp,
+		RequestID: entry.RequestID,
+		ClientIP:  entry.ClientIP,
+		Method:    entry.Method,
+		Seed:      entry.Seed,
+		Algorithm: entity.Algorithm{Name: entry.Algorithm, Version: entry.AlgorithmVersion},
+		Output:    entry.Output,
+	}
+}
+
+func encodeDrawCursor(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(offset, 10)))
+}
+
+func decodeDrawCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errors.New("validate: invalid cursor")
+	}
+	offset, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errors.New("validate: invalid cursor")
+	}
+
+	return offset, nil
+}
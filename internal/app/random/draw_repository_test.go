@@ -0,0 +1,179 @@
+package random
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/journal"
+)
+
+// newTestDrawRepository returns a repository saving to a journal in a
+// temporary directory, and the time of its first test draw.
+func newTestDrawRepository(t *testing.T) (*DrawRepo, time.Time) {
+	j, err := journal.Open(&config.Journal{
+		Enabled:    true,
+		Path:       filepath.Join(t.TempDir(), "journal.log"),
+		BufferSize: 10,
+	})
+	assert.NoError(t, err)
+	go j.Run()
+	t.Cleanup(func() { j.Close() })
+
+	return NewDrawRepository(j), time.Unix(1700000000, 0)
+}
+
+// saveTestDraws saves count draws a second apart, seeded 2, 3, 2, ... from
+// two client IPs, and waits for them to be listed.
+func saveTestDraws(t *testing.T, repo *DrawRepo, start time.Time, count int) {
+	ctx := context.Background()
+	page, err := repo.List(ctx, entity.DrawFilter{}, "", 1000)
+	assert.NoError(t, err)
+	saved := len(page.Draws) + count
+
+	for i := 0; i < count; i++ {
+		assert.NoError(t, repo.Save(ctx, entity.Draw{
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			RequestID: fmt.Sprintf("request-%d", i),
+			ClientIP:  fmt.Sprintf("192.0.2.%d", i%2+1),
+			Method:    "/random.RandomService/GetRandNumber",
+			Seed:      int64(i%2 + 2),
+			Algorithm: entity.Algorithm{Name: PCG, Version: "1"},
+			Output:    json.RawMessage(fmt.Sprintf(`{"Number":"%d"}`, i)),
+		}))
+	}
+
+	assert.Eventually(t, func() bool {
+		page, err := repo.List(ctx, entity.DrawFilter{}, "", 1000)
+		return err == nil && len(page.Draws) == saved
+	}, 5*time.Second, time.Millisecond, "Expected %d saved draws", saved)
+}
+
+func TestDrawRepo_List(t *testing.T) {
+	repo, start := newTestDrawRepository(t)
+	saveTestDraws(t, repo, start, 6)
+	ctx := context.Background()
+
+	page, err := repo.List(ctx, entity.DrawFilter{}, "", 10)
+	assert.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	first := page.Draws[0]
+	assert.Equal(t, uint64(1), first.Sequence)
+	assert.True(t, start.Equal(first.Timestamp))
+	assert.Equal(t, "request-0", first.RequestID)
+	assert.Equal(t, "192.0.2.1", first.ClientIP)
+	assert.Equal(t, int64(2), first.Seed)
+	assert.Equal(t, entity.Algorithm{Name: PCG, Version: "1"}, first.Algorithm)
+	assert.JSONEq(t, `{"Number":"0"}`, string(first.Output))
+
+	tests := []struct {
+		name      string
+		filter    entity.DrawFilter
+		sequences []uint64
+	}{
+		{
+			name:      "Time range",
+			filter:    entity.DrawFilter{From: start.Add(time.Second), To: start.Add(3 * time.Second)},
+			sequences: []uint64{2, 3},
+		},
+		{
+			name:      "Open time range",
+			filter:    entity.DrawFilter{From: start.Add(4 * time.Second)},
+			sequences: []uint64{5, 6},
+		},
+		{
+			name:      "Seed",
+			filter:    entity.DrawFilter{Seed: 3},
+			sequences: []uint64{2, 4, 6},
+		},
+		{
+			name:      "Client IP and time range",
+			filter:    entity.DrawFilter{ClientIP: "192.0.2.1", To: start.Add(3 * time.Second)},
+			sequences: []uint64{1, 3},
+		},
+		{
+			name:      "Request ID",
+			filter:    entity.DrawFilter{RequestID: "request-4"},
+			sequences: []uint64{5},
+		},
+		{
+			name:   "No match",
+			filter: entity.DrawFilter{ClientIP: "198.51.100.1"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			page, err := repo.List(ctx, tc.filter, "", 10)
+			assert.NoError(t, err)
+			var sequences []uint64
+			for _, draw := range page.Draws {
+				sequences = append(sequences, draw.Sequence)
+			}
+			assert.Equal(t, tc.sequences, sequences)
+		})
+	}
+}
+
+func TestDrawRepo_Pagination(t *testing.T) {
+	repo, start := newTestDrawRepository(t)
+	saveTestDraws(t, repo, start, 7)
+	ctx := context.Background()
+
+	filter := entity.DrawFilter{Seed: 2}
+	var sequences []uint64
+	var cursor string
+	for pages := 1; ; pages++ {
+		page, err := repo.List(ctx, filter, cursor, 2)
+		assert.NoError(t, err)
+		for _, draw := range page.Draws {
+			sequences = append(sequences, draw.Sequence)
+		}
+		if page.NextCursor == "" {
+			assert.Equal(t, 2, pages, "Expected no empty last page")
+			break
+		}
+		cursor = page.NextCursor
+	}
+	assert.Equal(t, []uint64{1, 3, 5, 7}, sequences)
+
+	// Draws saved after a page are listed on the next one
+	page, err := repo.List(ctx, entity.DrawFilter{}, "", 7)
+	assert.NoError(t, err)
+	assert.Empty(t, page.NextCursor)
+	saveTestDraws(t, repo, start.Add(time.Hour), 1)
+	page, err = repo.List(ctx, entity.DrawFilter{}, encodeDrawCursor(0), 7)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, page.NextCursor)
+	page, err = repo.List(ctx, entity.DrawFilter{}, page.NextCursor, 7)
+	assert.NoError(t, err)
+	assert.Len(t, page.Draws, 1)
+	assert.Equal(t, uint64(8), page.Draws[0].Sequence)
+}
+
+func TestDrawRepo_InvalidCursor(t *testing.T) {
+	repo, start := newTestDrawRepository(t)
+	saveTestDraws(t, repo, start, 2)
+
+	for _, cursor := range []string{"!", encodeDrawCursor(-1), encodeDrawCursor(3), "bm90IGEgbnVtYmVy"} {
+		_, err := repo.List(context.Background(), entity.DrawFilter{}, cursor, 10)
+		assert.ErrorContains(t, err, "validate:", "cursor %q", cursor)
+	}
+}
+
+func TestDrawRepo_Disabled(t *testing.T) {
+	j, err := journal.Open(&config.Journal{})
+	assert.NoError(t, err)
+	repo := NewDrawRepository(j)
+
+	assert.NoError(t, repo.Save(context.Background(), entity.Draw{}))
+	_, err = repo.List(context.Background(), entity.DrawFilter{}, "", 10)
+	assert.ErrorIs(t, err, grpc_errors.ErrDisabled)
+}
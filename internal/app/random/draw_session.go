@@ -0,0 +1,98 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+// maxSkip is the largest number of values a draw session skips at once.
+const maxSkip = 1000000
+
+var errNotSeeded = errors.New("validate: the draw session must be reseeded before drawing")
+
+// drawSession keeps the generator of one DrawSession stream. Draws are not
+// traced one by one, the stream is.
+type drawSession struct {
+	registry *Registry
+	rand     *rand.Rand
+	position int64
+}
+
+func newDrawSession(registry *Registry) *drawSession {
+	return &drawSession{
+		registry: registry,
+	}
+}
+
+func (d *drawSession) Reseed(seed int64, algorithm string) (entity.Algorithm, error) {
+	if err := validateSeed(seed); err != nil {
+		return entity.Algorithm{}, err
+	}
+
+	repo, err := d.registry.repo(algorithm)
+	if err != nil {
+		return entity.Algorithm{}, err
+	}
+	d.rand = repo.newRand(seed)
+	d.position = 0
+
+	return repo.Algorithm(), nil
+}
+
+func (d *drawSession) Next(count int) (entity.Sequence, error) {
+	return d.draw(count, func() int64 {
+		return d.rand.Int63()
+	})
+}
+
+func (d *drawSession) NextInRange(bounds entity.IntRange, count int) (entity.Sequence, error) {
+	if bounds.Min > bounds.Max {
+		return entity.Sequence{}, errors.New("validate: min must be less than or equal to max")
+	}
+
+	return d.draw(count, func() int64 {
+		return intInRange(d.rand, bounds)
+	})
+}
+
+func (d *drawSession) Skip(n int64) (entity.Sequence, error) {
+	if n < 0 || n > maxSkip {
+		return entity.Sequence{}, fmt.Errorf("validate: skip must be between 0 and %d", maxSkip)
+	}
+	if d.rand == nil {
+		return entity.Sequence{}, errNotSeeded
+	}
+
+	for i := int64(0); i < n; i++ {
+		d.rand.Int63()
+	}
+	d.position += n
+
+	return entity.Sequence{
+		Index: d.position,
+	}, nil
+}
+
+func (d *drawSession) draw(count int, next func() int64) (entity.Sequence, error) {
+	if count < 1 || count > maxSequenceCount {
+		return entity.Sequence{}, fmt.Errorf("validate: count must be between 1 and %d", maxSequenceCount)
+	}
+	if d.rand == nil {
+		return entity.Sequence{}, errNotSeeded
+	}
+
+	numbers := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		numbers = append(numbers, next())
+	}
+	index := d.position
+	d.position += int64(count)
+
+	return entity.Sequence{
+		Numbers: numbers,
+		Index:   index,
+	}, nil
+}
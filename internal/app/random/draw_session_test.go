@@ -0,0 +1,93 @@
+package random
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+func newTestDrawSession(t *testing.T) *drawSession {
+	registry, err := NewRegistry(&config.Random{DefaultAlgorithm: Legacy})
+	assert.NoError(t, err)
+
+	return newDrawSession(registry)
+}
+
+func TestDrawSession_ContinuesSequence(t *testing.T) {
+	session := newTestDrawSession(t)
+
+	var streamed []int64
+	err := NewRepository().Stream(context.Background(), 42, 10, func(randNum entity.Random) error {
+		streamed = append(streamed, randNum.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	algorithm, err := session.Reseed(42, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Legacy, algorithm.Name)
+
+	first, err := session.Next(3)
+	assert.NoError(t, err)
+	assert.Equal(t, streamed[:3], first.Numbers)
+
+	skipped, err := session.Skip(4)
+	assert.NoError(t, err)
+	assert.Empty(t, skipped.Numbers)
+	assert.Equal(t, int64(7), skipped.Index)
+
+	last, err := session.Next(3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), last.Index)
+	assert.Equal(t, streamed[7:], last.Numbers, "Expected skip to discard values of the sequence")
+
+	// Reseeding starts over
+	_, err = session.Reseed(42, "")
+	assert.NoError(t, err)
+	again, err := session.Next(3)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), again.Index)
+	assert.Equal(t, streamed[:3], again.Numbers)
+}
+
+func TestDrawSession_NextInRange(t *testing.T) {
+	session := newTestDrawSession(t)
+	_, err := session.Reseed(42, PCG)
+	assert.NoError(t, err)
+
+	sequence, err := session.NextInRange(entity.IntRange{Min: 1, Max: 6}, 100)
+	assert.NoError(t, err)
+	assert.Len(t, sequence.Numbers, 100)
+	for _, n := range sequence.Numbers {
+		assert.GreaterOrEqual(t, n, int64(1))
+		assert.LessOrEqual(t, n, int64(6))
+	}
+
+	_, err = session.NextInRange(entity.IntRange{Min: 6, Max: 1}, 1)
+	assert.ErrorContains(t, err, "validate")
+}
+
+func TestDrawSession_Invalid(t *testing.T) {
+	session := newTestDrawSession(t)
+
+	_, err := session.Next(1)
+	assert.ErrorIs(t, err, errNotSeeded)
+	_, err = session.Skip(1)
+	assert.ErrorIs(t, err, errNotSeeded)
+
+	_, err = session.Reseed(1, "")
+	assert.ErrorContains(t, err, "validate")
+	_, err = session.Reseed(42, "mt19937")
+	assert.ErrorContains(t, err, "validate")
+
+	_, err = session.Reseed(42, "")
+	assert.NoError(t, err)
+	_, err = session.Next(maxSequenceCount + 1)
+	assert.ErrorContains(t, err, "validate")
+	_, err = session.Skip(maxSkip + 1)
+	assert.ErrorContains(t, err, "validate")
+}
@@ -0,0 +1,128 @@
+package random
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+// exportChunkSize is the size of the chunks ExportDraws sends but the last.
+const exportChunkSize = 32 << 10
+
+// exportColumns are the columns of CSV exports, and the names of the fields
+// of NDJSON ones.
+var exportColumns = []string{"sequence", "timestamp", "request_id", "client_ip", "method", "seed", "algorithm", "algorithm_version", "output"}
+
+// drawEncoder writes draws to an export file.
+type drawEncoder interface {
+	Encode(draw entity.Draw) error
+	// Flush writes what the encoder buffers.
+	Flush() error
+}
+
+func newDrawEncoder(format pb.ExportFormat, w io.Writer) drawEncoder {
+	if format == pb.ExportFormat_EXPORT_FORMAT_NDJSON {
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		return ndjsonDrawEncoder{encoder: encoder}
+	}
+
+	return newCSVDrawEncoder(w)
+}
+
+type csvDrawEncoder struct {
+	writer *csv.Writer
+	header bool
+}
+
+func newCSVDrawEncoder(w io.Writer) *csvDrawEncoder {
+	return &csvDrawEncoder{writer: csv.NewWriter(w)}
+}
+
+func (e *csvDrawEncoder) Encode(draw entity.Draw) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+
+	return e.writer.Write([]string{
+		strconv.FormatUint(draw.Sequence, 10),
+		draw.Timestamp.UTC().Format(time.RFC3339Nano),
+		draw.RequestID,
+		draw.ClientIP,
+		draw.Method,
+		strconv.FormatInt(draw.Seed, 10),
+		draw.Algorithm.Name,
+		draw.Algorithm.Version,
+		string(draw.Output),
+	})
+}
+
+// Flush writes the header too when no draw was encoded.
+func (e *csvDrawEncoder) Flush() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+
+	return e.writer.Error()
+}
+
+func (e *csvDrawEncoder) writeHeader() error {
+	if e.header {
+		return nil
+	}
+	e.header = true
+
+	return e.writer.Write(exportColumns)
+}
+
+type ndjsonDrawEncoder struct {
+	encoder *json.Encoder
+}
+
+// exportedDraw is a line of NDJSON exports, its fields are exportColumns.
+type exportedDraw struct {
+	Sequence         uint64          `json:"sequence"`
+	Timestamp        time.Time       `json:"timestamp"`
+	RequestID        string          `json:"request_id"`
+	ClientIP         string          `json:"client_ip"`
+	Method           string          `json:"method"`
+	Seed             int64           `json:"seed"`
+	Algorithm        string          `json:"algorithm"`
+	AlgorithmVersion string          `json:"algorithm_version"`
+	Output           json.RawMessage `json:"output"`
+}
+
+func (e ndjsonDrawEncoder) Encode(draw entity.Draw) error {
+	return e.encoder.Encode(exportedDraw{
+		Sequence:         draw.Sequence,
+		Timestamp:        draw.Timestamp.UTC(),
+		RequestID:        draw.RequestID,
+		ClientIP:         draw.ClientIP,
+		Method:           draw.Method,
+		Seed:             draw.Seed,
+		Algorithm:        draw.Algorithm.Name,
+		AlgorithmVersion: draw.Algorithm.Version,
+		Output:           draw.Output,
+	})
+}
+
+func (ndjsonDrawEncoder) Flush() error {
+	return nil
+}
+
+// chunkWriter sends every write as a chunk, the bytes written are not kept.
+type chunkWriter func(chunk []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w(p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,96 @@
+package random
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func testExportDraw() entity.Draw {
+	return entity.Draw{
+		Sequence:  7,
+		Timestamp: time.Unix(1700000000, 5).In(time.FixedZone("UTC+7", 7*3600)),
+		RequestID: "request-1",
+		ClientIP:  "192.0.2.1",
+		Method:    "/random.RandomService/GetRandNumber",
+		Seed:      42,
+		Algorithm: entity.Algorithm{Name: PCG, Version: "1"},
+		Output:    json.RawMessage(`{"Number":"1","Note":"a, \"b\"\n<c>"}`),
+	}
+}
+
+func TestDrawEncoder_CSV(t *testing.T) {
+	for _, format := range []pb.ExportFormat{pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, pb.ExportFormat_EXPORT_FORMAT_CSV} {
+		var b bytes.Buffer
+		encoder := newDrawEncoder(format, &b)
+		assert.NoError(t, encoder.Encode(testExportDraw()))
+		assert.NoError(t, encoder.Flush())
+
+		records, err := csv.NewReader(&b).ReadAll()
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{
+			exportColumns,
+			{"7", "2023-11-14T22:13:20.000000005Z", "request-1", "192.0.2.1", "/random.RandomService/GetRandNumber", "42", PCG, "1", `{"Number":"1","Note":"a, \"b\"\n<c>"}`},
+		}, records, "format %v", format)
+	}
+
+	var b bytes.Buffer
+	encoder := newDrawEncoder(pb.ExportFormat_EXPORT_FORMAT_CSV, &b)
+	assert.NoError(t, encoder.Flush())
+	assert.Equal(t, strings.Join(exportColumns, ",")+"\n", b.String(), "Expected empty exports to keep their header")
+}
+
+func TestDrawEncoder_NDJSON(t *testing.T) {
+	var b bytes.Buffer
+	encoder := newDrawEncoder(pb.ExportFormat_EXPORT_FORMAT_NDJSON, &b)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, encoder.Encode(testExportDraw()))
+	}
+	assert.NoError(t, encoder.Flush())
+
+	lines := strings.Split(strings.TrimSuffix(b.String(), "\n"), "\n")
+	assert.Len(t, lines, 2)
+	assert.JSONEq(t, `{
+		"sequence": 7,
+		"timestamp": "2023-11-14T22:13:20.000000005Z",
+		"request_id": "request-1",
+		"client_ip": "192.0.2.1",
+		"method": "/random.RandomService/GetRandNumber",
+		"seed": 42,
+		"algorithm": "pcg",
+		"algorithm_version": "1",
+		"output": {"Number": "1", "Note": "a, \"b\"\n<c>"}
+	}`, lines[0])
+
+	var fields map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &fields))
+	for _, column := range exportColumns {
+		assert.Contains(t, fields, column)
+	}
+}
+
+func TestChunkWriter(t *testing.T) {
+	var chunks []string
+	w := chunkWriter(func(chunk []byte) error {
+		chunks = append(chunks, string(chunk))
+		return nil
+	})
+	n, err := w.Write([]byte("abc"))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"abc"}, chunks)
+
+	failing := chunkWriter(func([]byte) error { return errors.New("closed") })
+	n, err = failing.Write([]byte("abc"))
+	assert.Error(t, err)
+	assert.Zero(t, n)
+}
@@ -0,0 +1,247 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxExpressionLength is the longest expression integral jobs accept.
+const maxExpressionLength = 256
+
+// functions are the functions expressions can call.
+var functions = map[string]func(float64) float64{
+	"abs":  math.Abs,
+	"cos":  math.Cos,
+	"exp":  math.Exp,
+	"log":  math.Log,
+	"sin":  math.Sin,
+	"sqrt": math.Sqrt,
+	"tan":  math.Tan,
+}
+
+// parseExpression compiles an arithmetic expression of x. Identifiers are
+// case-insensitive and spaces between tokens are ignored:
+//
+//	expression = term {("+" | "-") term}
+//	term       = unary {("*" | "/") unary}
+//	unary      = ("+" | "-") unary | power
+//	power      = primary ["^" unary]
+//	primary    = number | "x" | "pi" | "e" | function "(" expression ")" | "(" expression ")"
+//
+// "^" binds tighter than unary minus and is right-associative, so -x^2 is
+// -(x^2) and 2^3^2 is 2^9. The functions are listed in functions.
+func parseExpression(expression string) (func(x float64) float64, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, errors.New("validate: expression is empty")
+	}
+	if len(expression) > maxExpressionLength {
+		return nil, fmt.Errorf("validate: expression is longer than %d characters", maxExpressionLength)
+	}
+
+	p := expressionParser{expression: expression, s: strings.ToLower(expression)}
+	f, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != 0 {
+		return nil, p.errorf("expected an operator")
+	}
+
+	return f, nil
+}
+
+type expressionParser struct {
+	expression string
+	// s is the lower-cased expression
+	s   string
+	pos int
+}
+
+func (p *expressionParser) sum() (func(float64) float64, error) {
+	f, err := p.product()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '+' && op != '-' {
+			return f, nil
+		}
+		p.pos++
+
+		g, err := p.product()
+		if err != nil {
+			return nil, err
+		}
+		left := f
+		if op == '+' {
+			f = func(x float64) float64 { return left(x) + g(x) }
+		} else {
+			f = func(x float64) float64 { return left(x) - g(x) }
+		}
+	}
+}
+
+func (p *expressionParser) product() (func(float64) float64, error) {
+	f, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	for {
+		op := p.peek()
+		if op != '*' && op != '/' {
+			return f, nil
+		}
+		p.pos++
+
+		g, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left := f
+		if op == '*' {
+			f = func(x float64) float64 { return left(x) * g(x) }
+		} else {
+			f = func(x float64) float64 { return left(x) / g(x) }
+		}
+	}
+}
+
+func (p *expressionParser) unary() (func(float64) float64, error) {
+	switch p.peek() {
+	case '+':
+		p.pos++
+		return p.unary()
+	case '-':
+		p.pos++
+		f, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(x float64) float64 { return -f(x) }, nil
+	}
+
+	return p.power()
+}
+
+func (p *expressionParser) power() (func(float64) float64, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek() != '^' {
+		return base, nil
+	}
+	p.pos++
+
+	exponent, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(x float64) float64 { return math.Pow(base(x), exponent(x)) }, nil
+}
+
+func (p *expressionParser) primary() (func(float64) float64, error) {
+	c := p.peek()
+	switch {
+	case c == '(':
+		p.pos++
+		f, err := p.sum()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(')'); err != nil {
+			return nil, err
+		}
+		return f, nil
+	case c == '.' || (c >= '0' && c <= '9'):
+		return p.number()
+	case c >= 'a' && c <= 'z':
+		return p.identifier()
+	}
+
+	return nil, p.errorf("expected a number, x, a function or (")
+}
+
+func (p *expressionParser) number() (func(float64) float64, error) {
+	start := p.pos
+	for p.pos < len(p.s) && (p.s[p.pos] == '.' || (p.s[p.pos] >= '0' && p.s[p.pos] <= '9')) {
+		p.pos++
+	}
+
+	v, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		return nil, fmt.Errorf("validate: invalid number at position %d of %q", start+1, p.expression)
+	}
+
+	return func(float64) float64 { return v }, nil
+}
+
+func (p *expressionParser) identifier() (func(float64) float64, error) {
+	start := p.pos
+	for p.pos < len(p.s) && p.s[p.pos] >= 'a' && p.s[p.pos] <= 'z' {
+		p.pos++
+	}
+	name := p.s[start:p.pos]
+
+	switch name {
+	case "x":
+		return func(x float64) float64 { return x }, nil
+	case "pi":
+		return func(float64) float64 { return math.Pi }, nil
+	case "e":
+		return func(float64) float64 { return math.E }, nil
+	}
+
+	fn, ok := functions[name]
+	if !ok {
+		return nil, fmt.Errorf("validate: unknown identifier %q at position %d of %q", name, start+1, p.expression)
+	}
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	arg, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(')'); err != nil {
+		return nil, err
+	}
+
+	return func(x float64) float64 { return fn(arg(x)) }, nil
+}
+
+func (p *expressionParser) expect(c byte) error {
+	if p.peek() != c {
+		return p.errorf(fmt.Sprintf("expected %q", c))
+	}
+	p.pos++
+
+	return nil
+}
+
+// peek returns the next character that is not a space, or 0 at the end.
+func (p *expressionParser) peek() byte {
+	for p.pos < len(p.s) && p.s[p.pos] == ' ' {
+		p.pos++
+	}
+	if p.pos == len(p.s) {
+		return 0
+	}
+
+	return p.s[p.pos]
+}
+
+func (p *expressionParser) errorf(expected string) error {
+	if p.pos == len(p.s) {
+		return fmt.Errorf("validate: %s at the end of %q", expected, p.expression)
+	}
+
+	return fmt.Errorf("validate: %s at position %d of %q", expected, p.pos+1, p.expression)
+}
@@ -0,0 +1,69 @@
+package random
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		x          float64
+		expect     float64
+	}{
+		{name: "Constant", expression: "42", x: 7, expect: 42},
+		{name: "Variable", expression: "x", x: 7, expect: 7},
+		{name: "Precedence", expression: "1 + 2 * x - 6 / 3", x: 4, expect: 7},
+		{name: "Parentheses", expression: "(1 + 2) * x", x: 4, expect: 12},
+		{name: "Power Is Right Associative", expression: "2^3^2", expect: 512},
+		{name: "Unary Minus Binds Looser Than Power", expression: "-x^2", x: 3, expect: -9},
+		{name: "Negative Exponent", expression: "2^-1", expect: 0.5},
+		{name: "Functions", expression: "sqrt(abs(x)) + exp(0) + log(e)", x: -16, expect: 6},
+		{name: "Trigonometry", expression: "sin(pi / 2) + cos(0) + tan(0)", expect: 2},
+		{name: "Case And Spaces", expression: " 4 / ( 1 + X ^ 2 ) ", x: 1, expect: 2},
+		{name: "Decimals", expression: ".5 * 1.5", expect: 0.75},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := parseExpression(tt.expression)
+			assert.NoError(t, err)
+			assert.InDelta(t, tt.expect, f(tt.x), 1e-12)
+		})
+	}
+}
+
+func TestParseExpressionNotFinite(t *testing.T) {
+	f, err := parseExpression("1 / x")
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(f(0), 1), "Expected division by zero to be left to the caller")
+}
+
+func TestParseExpressionInvalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+	}{
+		{name: "Empty", expression: " "},
+		{name: "Too Long", expression: strings.Repeat("x+", maxExpressionLength) + "x"},
+		{name: "Unknown Identifier", expression: "y + 1"},
+		{name: "Unknown Function", expression: "floor(x)"},
+		{name: "Function Without Parentheses", expression: "sin x"},
+		{name: "Unbalanced", expression: "(x + 1"},
+		{name: "Implicit Product", expression: "2x"},
+		{name: "Dangling Operator", expression: "x +"},
+		{name: "Invalid Number", expression: "1.2.3"},
+		{name: "Invalid Character", expression: "x % 2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseExpression(tt.expression)
+			assert.ErrorContains(t, err, "validate")
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package random
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/log"
+)
+
+// maxIdempotencyKeyLength is the longest idempotency key accepted.
+const maxIdempotencyKeyLength = 255
+
+// Idempotency makes unary draws called with an idempotency key happen once:
+// retries with the key get the reply of the first call instead of a new
+// draw. Keys are scoped to the API key of the client, or to its IP when it
+// sends none, and only successful replies are kept, so failed calls can be
+// retried.
+type Idempotency struct {
+	calls entity.IIdempotencyRepository
+}
+
+func NewIdempotency(calls entity.IIdempotencyRepository) *Idempotency {
+	return &Idempotency{
+		calls: calls,
+	}
+}
+
+// Unary replays the reply of draws retried with the same key.
+func (i *Idempotency) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	key := grpcUtils.GetIdempotencyKeyFromContext(ctx)
+	_, method := grpcUtils.SplitMethodName(info.FullMethod)
+	request, ok := req.(proto.Message)
+	if key == "" || !journaledMethods[method] || !ok {
+		return handler(ctx, req)
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("validate: idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+	}
+
+	fingerprint, err := callFingerprint(info.FullMethod, request)
+	if err != nil {
+		return nil, err
+	}
+	scope := callerScope(ctx)
+	if scope == "" {
+		// Callers sharing an empty scope would get each other's replies
+		return nil, errors.New("validate: idempotency keys need an API key or a known client IP")
+	}
+	scopedKey := scope + " " + key
+	call, reserved, err := i.calls.Reserve(ctx, scopedKey, fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	if !reserved {
+		return replay(call, key, fingerprint)
+	}
+
+	reply, err := handler(ctx, req)
+	if err != nil {
+		if err := i.calls.Release(ctx, scopedKey); err != nil {
+			log.GetLogger().Errorf("failed to release idempotency key %q: %v", key, err)
+		}
+		return reply, err
+	}
+
+	data, err := marshalReply(reply)
+	if err == nil {
+		err = i.calls.Complete(ctx, scopedKey, data)
+	}
+	if err != nil {
+		// Retries draw again rather than failing on a key that never completes
+		log.GetLogger().Errorf("failed to store the reply of idempotency key %q: %v", key, err)
+		_ = i.calls.Release(ctx, scopedKey)
+	}
+
+	return reply, nil
+}
+
+// callerScope returns what identifies the caller of ctx, like the clients of
+// quotas: its API key, or its IP when it sends none. It is empty when neither
+// is known.
+func callerScope(ctx context.Context) string {
+	if key := grpcUtils.GetAPIKeyFromContext(ctx); key != "" {
+		return "key:" + key
+	}
+	if ip := clientIP(ctx); ip != "" {
+		return "ip:" + ip
+	}
+
+	return ""
+}
+
+// replay returns the reply of call, which claimed key first.
+func replay(call entity.IdempotentCall, key string, fingerprint string) (interface{}, error) {
+	if call.Fingerprint != fingerprint {
+		return nil, fmt.Errorf("validate: idempotency key %q was already used by a call with a different method or parameters", key)
+	}
+	if !call.Done() {
+		return nil, fmt.Errorf("the call with idempotency key %q is in progress, retry later: %w", key, grpc_errors.ErrConflict)
+	}
+
+	var reply anypb.Any
+	if err := proto.Unmarshal(call.Reply, &reply); err != nil {
+		return nil, fmt.Errorf("invalid stored reply for idempotency key %q: %w", key, err)
+	}
+	message, err := reply.UnmarshalNew()
+	if err != nil {
+		return nil, fmt.Errorf("invalid stored reply for idempotency key %q: %w", key, err)
+	}
+
+	return message, nil
+}
+
+// callFingerprint returns the hex SHA-256 of the method and the request.
+func callFingerprint(fullMethod string, request proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(fullMethod))
+	hash.Write([]byte{0})
+	hash.Write(data)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func marshalReply(reply interface{}) ([]byte, error) {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return nil, errors.New("reply is not a protobuf message")
+	}
+	wrapped, err := anypb.New(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return proto.Marshal(wrapped)
+}
@@ -0,0 +1,120 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// IdempotencyRepo keeps idempotent calls in memory for TTL. At most MaxKeys
+// calls are kept at once.
+type IdempotencyRepo struct {
+	config *config.Idempotency
+	now    func() time.Time
+
+	mu    sync.Mutex
+	calls map[string]entity.IdempotentCall
+}
+
+func NewIdempotencyRepository(config *config.Idempotency) *IdempotencyRepo {
+	return &IdempotencyRepo{
+		config: config,
+		now:    time.Now,
+		calls:  make(map[string]entity.IdempotentCall),
+	}
+}
+
+func (r *IdempotencyRepo) Reserve(ctx context.Context, key string, fingerprint string) (entity.IdempotentCall, bool, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.ReserveIdempotencyKey")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	if call, ok := r.calls[key]; ok && now.Before(call.ExpiresAt) {
+		return call, false, nil
+	}
+
+	// Expired calls must not hold slots until the next sweep
+	if len(r.calls) >= r.config.MaxKeys {
+		r.evict()
+	}
+	if len(r.calls) >= r.config.MaxKeys {
+		return entity.IdempotentCall{}, false, fmt.Errorf("%d idempotency keys are in use, retry later: %w", len(r.calls), grpc_errors.ErrExhausted)
+	}
+
+	call := entity.IdempotentCall{
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   now.Add(r.config.TTL),
+	}
+	r.calls[key] = call
+
+	return call, true, nil
+}
+
+func (r *IdempotencyRepo) Complete(ctx context.Context, key string, reply []byte) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CompleteIdempotencyKey")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	call, ok := r.calls[key]
+	if !ok {
+		return fmt.Errorf("idempotency key %q: %w", key, grpc_errors.ErrNotFound)
+	}
+	call.Reply = reply
+	r.calls[key] = call
+
+	return nil
+}
+
+func (r *IdempotencyRepo) Release(ctx context.Context, key string) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.ReleaseIdempotencyKey")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.calls, key)
+
+	return nil
+}
+
+// Run removes expired calls periodically until stopCh is closed.
+func (r *IdempotencyRepo) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(min(r.config.TTL, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.evict()
+			r.mu.Unlock()
+		}
+	}
+}
+
+// evict removes the expired calls, r.mu must be held.
+func (r *IdempotencyRepo) evict() {
+	now := r.now()
+	for key, call := range r.calls {
+		if !now.Before(call.ExpiresAt) {
+			delete(r.calls, key)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package random
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+func newTestIdempotencyRepository(maxKeys int) (*IdempotencyRepo, *time.Time) {
+	now := time.Unix(0, 0)
+	repo := NewIdempotencyRepository(&config.Idempotency{
+		TTL:     time.Minute,
+		MaxKeys: maxKeys,
+	})
+	repo.now = func() time.Time {
+		return now
+	}
+
+	return repo, &now
+}
+
+func TestIdempotencyRepo_Reserve(t *testing.T) {
+	repo, now := newTestIdempotencyRepository(10)
+	ctx := context.Background()
+
+	call, reserved, err := repo.Reserve(ctx, "a", "fingerprint")
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+	assert.False(t, call.Done())
+	assert.Equal(t, now.Add(time.Minute), call.ExpiresAt)
+
+	call, reserved, err = repo.Reserve(ctx, "a", "other")
+	assert.NoError(t, err)
+	assert.False(t, reserved, "Expected a key to be claimed once")
+	assert.Equal(t, "fingerprint", call.Fingerprint)
+	assert.False(t, call.Done())
+
+	assert.NoError(t, repo.Complete(ctx, "a", []byte("reply")))
+	call, reserved, _ = repo.Reserve(ctx, "a", "fingerprint")
+	assert.False(t, reserved)
+	assert.True(t, call.Done())
+	assert.Equal(t, []byte("reply"), call.Reply)
+
+	// Expired keys can be claimed again
+	*now = now.Add(time.Minute)
+	_, reserved, err = repo.Reserve(ctx, "a", "other")
+	assert.NoError(t, err)
+	assert.True(t, reserved)
+
+	assert.ErrorIs(t, repo.Complete(ctx, "b", []byte("reply")), grpc_errors.ErrNotFound)
+}
+
+func TestIdempotencyRepo_Release(t *testing.T) {
+	repo, _ := newTestIdempotencyRepository(10)
+	ctx := context.Background()
+
+	_, _, err := repo.Reserve(ctx, "a", "fingerprint")
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Release(ctx, "a"))
+	_, reserved, err := repo.Reserve(ctx, "a", "fingerprint")
+	assert.NoError(t, err)
+	assert.True(t, reserved, "Expected released keys to be claimed again")
+	assert.NoError(t, repo.Release(ctx, "unknown"))
+}
+
+func TestIdempotencyRepo_MaxKeys(t *testing.T) {
+	repo, now := newTestIdempotencyRepository(2)
+	ctx := context.Background()
+
+	for _, key := range []string{"a", "b"} {
+		_, _, err := repo.Reserve(ctx, key, "fingerprint")
+		assert.NoError(t, err)
+	}
+	_, _, err := repo.Reserve(ctx, "c", "fingerprint")
+	assert.ErrorIs(t, err, grpc_errors.ErrExhausted)
+	// Claimed keys are still answered when full
+	_, reserved, err := repo.Reserve(ctx, "a", "fingerprint")
+	assert.NoError(t, err)
+	assert.False(t, reserved)
+
+	*now = now.Add(time.Minute)
+	_, reserved, err = repo.Reserve(ctx, "c", "fingerprint")
+	assert.NoError(t, err)
+	assert.True(t, reserved, "Expected expired keys to make room")
+	assert.Len(t, repo.calls, 1)
+}
@@ -0,0 +1,149 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+func newTestIdempotency() *Idempotency {
+	return NewIdempotency(NewIdempotencyRepository(&config.Idempotency{
+		TTL:     time.Minute,
+		MaxKeys: 10,
+	}))
+}
+
+func idempotentContext(clientIP, key string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(),
+		grpcUtils.ClientIPHeader, clientIP,
+		grpcUtils.IdempotencyKeyHeader, key,
+	)
+}
+
+func TestIdempotency(t *testing.T) {
+	randClient := newTestClient(t, grpc.ChainUnaryInterceptor(newTestIdempotency().Unary))
+	secure := &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE}
+
+	first, err := randClient.GetRandNumber(idempotentContext("192.0.2.1", "key-1"), secure)
+	assert.NoError(t, err)
+	retried, err := randClient.GetRandNumber(idempotentContext("192.0.2.1", "key-1"), secure)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(first, retried), "Expected a retry to get the first reply")
+
+	// Secure numbers differ without a key, with another key, or from another client
+	for _, ctx := range []context.Context{
+		context.Background(),
+		idempotentContext("192.0.2.1", "key-2"),
+		idempotentContext("192.0.2.2", "key-1"),
+	} {
+		other, err := randClient.GetRandNumber(ctx, secure)
+		assert.NoError(t, err)
+		assert.NotEqual(t, first.Number, other.Number)
+	}
+
+	// Reusing a key with other parameters or for another method is rejected
+	_, err = randClient.GetRandNumber(idempotentContext("192.0.2.1", "key-1"), &pb.GetRandNumberRequest{SeedNum: 42})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "different method or parameters")
+	_, err = randClient.GetRandBytes(idempotentContext("192.0.2.1", "key-1"), &pb.GetRandBytesRequest{Mode: pb.Mode_MODE_SECURE, Length: 8})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Failed calls are not kept
+	ctx := idempotentContext("192.0.2.1", "key-3")
+	_, err = randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 1})
+	assert.Error(t, err)
+	_, err = randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 42})
+	assert.NoError(t, err)
+
+	_, err = randClient.GetRandNumber(idempotentContext("192.0.2.1", strings.Repeat("k", 256)), secure)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestIdempotency_Scope(t *testing.T) {
+	randClient := newTestClient(t, grpc.ChainUnaryInterceptor(newTestIdempotency().Unary))
+	secure := &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE}
+	withAPIKey := func(ip, apiKey string) context.Context {
+		return metadata.AppendToOutgoingContext(idempotentContext(ip, "key-1"), grpcUtils.APIKeyHeader, apiKey)
+	}
+
+	// Clients with an API key keep their scope whatever their IP
+	first, err := randClient.GetRandNumber(withAPIKey("192.0.2.1", "acme-key"), secure)
+	assert.NoError(t, err)
+	retried, err := randClient.GetRandNumber(withAPIKey("192.0.2.2", "acme-key"), secure)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(first, retried), "Expected a retry from another IP to get the first reply")
+	for _, ctx := range []context.Context{
+		withAPIKey("192.0.2.1", "other-key"),
+		idempotentContext("192.0.2.1", "key-1"),
+	} {
+		other, err := randClient.GetRandNumber(ctx, secure)
+		assert.NoError(t, err)
+		assert.NotEqual(t, first.Number, other.Number, "Expected other clients not to get the reply")
+	}
+
+	// Callers without an identity do not share an empty scope
+	info := &grpc.UnaryServerInfo{FullMethod: "/random.RandomService/GetRandNumber"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(grpcUtils.IdempotencyKeyHeader, "key-1"))
+	_, err = newTestIdempotency().Unary(ctx, secure, info, func(context.Context, interface{}) (interface{}, error) {
+		return &pb.GetRandNumberReply{Number: 7}, nil
+	})
+	assert.ErrorContains(t, err, "validate: idempotency keys need an API key or a known client IP")
+}
+
+func TestIdempotency_InProgress(t *testing.T) {
+	idempotency := newTestIdempotency()
+	info := &grpc.UnaryServerInfo{FullMethod: "/random.RandomService/GetRandNumber"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		grpcUtils.ClientIPHeader, "192.0.2.1",
+		grpcUtils.IdempotencyKeyHeader, "key",
+	))
+	request := &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE}
+
+	started, release := make(chan struct{}), make(chan struct{})
+	done := make(chan error)
+	go func() {
+		_, err := idempotency.Unary(ctx, request, info, func(context.Context, interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return &pb.GetRandNumberReply{Number: 7}, nil
+		})
+		done <- err
+	}()
+
+	<-started
+	_, err := idempotency.Unary(ctx, request, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.New("drew twice")
+	})
+	assert.ErrorIs(t, err, grpc_errors.ErrConflict, "Expected a retry to fail while the first call is in progress")
+
+	close(release)
+	assert.NoError(t, <-done)
+	reply, err := idempotency.Unary(ctx, request, info, func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.New("drew twice")
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), reply.(*pb.GetRandNumberReply).Number)
+
+	// Methods that do not draw are not affected
+	info = &grpc.UnaryServerInfo{FullMethod: "/random.RandomService/GetPublicKey"}
+	for i := 0; i < 2; i++ {
+		_, err = idempotency.Unary(ctx, &pb.GetPublicKeyRequest{}, info, func(context.Context, interface{}) (interface{}, error) {
+			return &pb.GetPublicKeyReply{}, nil
+		})
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,47 @@
+package random
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// tokenAlphabet is the URL-safe base64 alphabet. It has exactly 64 symbols,
+// so the low 6 bits of a random byte pick one without bias.
+const tokenAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// newToken maps each random byte to a symbol of tokenAlphabet.
+func newToken(b []byte) string {
+	token := make([]byte, len(b))
+	for i, v := range b {
+		token[i] = tokenAlphabet[v&63]
+	}
+
+	return string(token)
+}
+
+// newUUIDv4 turns 16 random bytes into a version 4 UUID (RFC 9562).
+func newUUIDv4(b []byte) string {
+	var u uuid.UUID
+	copy(u[:], b)
+	u[6] = (u[6] & 0x0f) | 0x40 // version 4
+	u[8] = (u[8] & 0x3f) | 0x80 // variant 10
+
+	return u.String()
+}
+
+// newUUIDv7 turns 16 random bytes into a version 7 UUID (RFC 9562),
+// whose first 48 bits are the Unix time of now in milliseconds.
+func newUUIDv7(b []byte, now time.Time) string {
+	var u uuid.UUID
+	copy(u[:], b)
+
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], uint64(now.UnixMilli()))
+	copy(u[0:6], ts[2:8])
+	u[6] = (u[6] & 0x0f) | 0x70 // version 7
+	u[8] = (u[8] & 0x3f) | 0x80 // variant 10
+
+	return u.String()
+}
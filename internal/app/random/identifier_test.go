@@ -0,0 +1,45 @@
+package random
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewUUIDv4(t *testing.T) {
+	b := make([]byte, 16)
+	for i := range b {
+		b[i] = 0xff
+	}
+
+	u, err := uuid.Parse(newUUIDv4(b))
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Version(4), u.Version())
+	assert.Equal(t, uuid.RFC4122, u.Variant())
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	now := time.UnixMilli(1700000000123)
+
+	u, err := uuid.Parse(newUUIDv7(make([]byte, 16), now))
+	assert.NoError(t, err)
+	assert.Equal(t, uuid.Version(7), u.Version())
+	assert.Equal(t, uuid.RFC4122, u.Variant())
+
+	sec, nsec := u.Time().UnixTime()
+	assert.Equal(t, now.UnixMilli(), time.Unix(sec, nsec).UnixMilli(), "Expected the timestamp in the first 48 bits")
+}
+
+func TestNewToken(t *testing.T) {
+	b := make([]byte, 256)
+	for i := range b {
+		b[i] = byte(i)
+	}
+
+	token := newToken(b)
+	assert.Len(t, token, 256)
+	assert.Regexp(t, "^[A-Za-z0-9_-]+$", token)
+	assert.Equal(t, tokenAlphabet+tokenAlphabet+tokenAlphabet+tokenAlphabet, token, "Expected every symbol to be equally likely")
+}
@@ -0,0 +1,103 @@
+package random
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+// jobChunk is the number of samples a job draws between progress updates
+// and cancellation checks.
+const jobChunk = 1 << 16
+
+// newJobSampler returns a function drawing successive samples of spec from
+// rand, and the scale turning their mean into the estimate.
+func newJobSampler(rand *rand.Rand, spec entity.JobSpec) (func() float64, float64, error) {
+	switch spec.Kind {
+	case entity.PiJob:
+		return func() float64 {
+			x, y := rand.Float64(), rand.Float64()
+			if x*x+y*y <= 1 {
+				return 1
+			}
+			return 0
+		}, 4, nil
+	case entity.IntegralJob:
+		f, err := parseExpression(spec.Expression)
+		if err != nil {
+			return nil, 0, err
+		}
+		return func() float64 {
+			return f(floatInRange(rand, spec.Range))
+		}, spec.Range.Max - spec.Range.Min, nil
+	}
+
+	return nil, 0, fmt.Errorf("validate: unknown job kind %q", spec.Kind)
+}
+
+// validateJob checks spec, samples is the largest number of samples allowed.
+func validateJob(spec entity.JobSpec, samples int64) error {
+	if spec.Samples < 1 || spec.Samples > samples {
+		return fmt.Errorf("validate: samples must be between 1 and %d", samples)
+	}
+
+	switch spec.Kind {
+	case entity.PiJob:
+		if spec.Expression != "" {
+			return errors.New("validate: pi jobs take no expression")
+		}
+	case entity.IntegralJob:
+		if !isFinite(spec.Range.Max - spec.Range.Min) {
+			return errors.New("validate: min and max must be finite and less than MaxFloat64 apart")
+		}
+		if spec.Range.Min >= spec.Range.Max {
+			return errors.New("validate: min must be less than max")
+		}
+	}
+
+	_, _, err := newJobSampler(nil, spec)
+	return err
+}
+
+// estimate draws the samples of spec from rand and calls progress with the
+// number of samples drawn after every chunk. It returns the mean of the
+// samples, scaled, and its standard error.
+func estimate(ctx context.Context, rand *rand.Rand, spec entity.JobSpec, progress func(int64)) (entity.JobResult, error) {
+	sample, scale, err := newJobSampler(rand, spec)
+	if err != nil {
+		return entity.JobResult{}, err
+	}
+
+	// Welford's algorithm keeps the running mean and variance stable
+	var mean, m2 float64
+	var n int64
+	for n < spec.Samples {
+		if err := ctx.Err(); err != nil {
+			return entity.JobResult{}, err
+		}
+
+		end := n + min(jobChunk, spec.Samples-n)
+		for n < end {
+			v := sample()
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return entity.JobResult{}, fmt.Errorf("sample %d is not a finite number", n)
+			}
+			n++
+			delta := v - mean
+			mean += delta / float64(n)
+			m2 += delta * (v - mean)
+		}
+		progress(n)
+	}
+
+	result := entity.JobResult{Estimate: scale * mean}
+	if n > 1 {
+		result.StdError = math.Abs(scale) * math.Sqrt(m2/float64(n-1)/float64(n))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,290 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// JobRepo runs Monte Carlo jobs on a pool of Workers. At most QueueSize jobs
+// wait for a worker and at most MaxJobs are kept. Finished jobs are kept
+// for Retention, or less when newer jobs need their slots.
+type JobRepo struct {
+	registry *Registry
+	config   *config.Jobs
+	now      func() time.Time
+	queue    chan *job
+
+	mu   sync.Mutex
+	jobs map[string]*job
+	// queued is the number of jobs in state queued, cancelled jobs can
+	// still be in the queue
+	queued int
+}
+
+// job is guarded by JobRepo.mu.
+type job struct {
+	entity.Job
+	// cancel stops a running job
+	cancel context.CancelFunc
+}
+
+func NewJobRepository(registry *Registry, config *config.Jobs) *JobRepo {
+	return &JobRepo{
+		registry: registry,
+		config:   config,
+		now:      time.Now,
+		queue:    make(chan *job, config.QueueSize),
+		jobs:     make(map[string]*job),
+	}
+}
+
+func (r *JobRepo) Submit(ctx context.Context, spec entity.JobSpec) (entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.SubmitJob")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := r.registry.repo(spec.Algorithm)
+	if err != nil {
+		return entity.Job{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.jobs) >= r.config.MaxJobs {
+		r.evict(len(r.jobs) - r.config.MaxJobs + 1)
+	}
+	if len(r.jobs) >= r.config.MaxJobs {
+		return entity.Job{}, fmt.Errorf("%d jobs are queued or running, wait for some to finish: %w", len(r.jobs), grpc_errors.ErrExhausted)
+	}
+
+	j := &job{Job: entity.Job{
+		ID:        uuid.NewString(),
+		Spec:      spec,
+		Algorithm: repo.Algorithm(),
+		State:     entity.JobQueued,
+		CreatedAt: r.now(),
+	}}
+	select {
+	case r.queue <- j:
+	default:
+		return entity.Job{}, fmt.Errorf("%d jobs are waiting for a worker, try again later: %w", len(r.queue), grpc_errors.ErrExhausted)
+	}
+	r.jobs[j.ID] = j
+	r.queued++
+	r.reportQueued()
+
+	return j.Job, nil
+}
+
+func (r *JobRepo) Get(ctx context.Context, id string) (entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetJob")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, ok := r.jobs[id]
+	if !ok {
+		return entity.Job{}, fmt.Errorf("job %s: %w", id, grpc_errors.ErrNotFound)
+	}
+
+	return j.Job, nil
+}
+
+func (r *JobRepo) Cancel(ctx context.Context, id string) (entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CancelJob")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j, ok := r.jobs[id]
+	if !ok {
+		return entity.Job{}, fmt.Errorf("job %s: %w", id, grpc_errors.ErrNotFound)
+	}
+
+	switch j.State {
+	case entity.JobQueued:
+		// The worker that dequeues it skips it
+		r.queued--
+		r.reportQueued()
+	case entity.JobRunning:
+		// The worker stops after the current chunk and keeps the state
+		j.cancel()
+	default:
+		return j.Job, nil
+	}
+	j.State = entity.JobCancelled
+	j.Error = "cancelled by the client"
+	j.FinishedAt = r.now()
+
+	return j.Job, nil
+}
+
+func (r *JobRepo) List(ctx context.Context, state entity.JobState) ([]entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.ListJobs")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	jobs := make([]entity.Job, 0, len(r.jobs))
+	for _, j := range r.jobs {
+		if state == "" || j.State == state {
+			jobs = append(jobs, j.Job)
+		}
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		if !jobs[i].CreatedAt.Equal(jobs[k].CreatedAt) {
+			return jobs[i].CreatedAt.After(jobs[k].CreatedAt)
+		}
+		return jobs[i].ID < jobs[k].ID
+	})
+
+	return jobs, nil
+}
+
+// Run runs the workers and drops finished jobs past their retention until
+// stopCh is closed, then cancels the running jobs and waits for the workers.
+func (r *JobRepo) Run(stopCh <-chan struct{}) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < r.config.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.work(ctx)
+		}()
+	}
+
+	ticker := time.NewTicker(min(r.config.Retention, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			cancel()
+			wg.Wait()
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.evict(0)
+			r.mu.Unlock()
+		}
+	}
+}
+
+// work runs queued jobs until ctx is done.
+func (r *JobRepo) work(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-r.queue:
+			r.execute(ctx, j)
+		}
+	}
+}
+
+func (r *JobRepo) execute(ctx context.Context, j *job) {
+	r.mu.Lock()
+	if j.State != entity.JobQueued {
+		r.mu.Unlock()
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	j.cancel = cancel
+	j.State = entity.JobRunning
+	j.StartedAt = r.now()
+	r.queued--
+	r.reportQueued()
+	spec := j.Spec
+	r.mu.Unlock()
+
+	var result entity.JobResult
+	repo, err := r.registry.repo(spec.Algorithm)
+	if err == nil {
+		result, err = estimate(ctx, repo.newRand(spec.Seed), spec, func(done int64) {
+			r.mu.Lock()
+			j.Progress = done
+			r.mu.Unlock()
+		})
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	j.cancel = nil
+	// Cancel already finished the job
+	if j.State == entity.JobRunning {
+		j.FinishedAt = r.now()
+		switch {
+		case err == nil:
+			j.State = entity.JobSucceeded
+			j.Result = result
+		case ctx.Err() != nil:
+			j.State = entity.JobCancelled
+			j.Error = "cancelled by the server shutting down"
+		default:
+			j.State = entity.JobFailed
+			j.Error = err.Error()
+		}
+	}
+
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(metric.Random_job_duration_seconds.Name) {
+		_ = metr.Histogram(metric.Random_job_duration_seconds, j.FinishedAt.Sub(j.StartedAt).Seconds(), string(spec.Kind), string(j.State))
+	}
+}
+
+// evict drops the finished jobs past their retention, then the oldest
+// finished jobs until at least n jobs are dropped. r.mu must be held.
+func (r *JobRepo) evict(n int) {
+	now := r.now()
+	var finished []*job
+	for id, j := range r.jobs {
+		if !j.State.Finished() {
+			continue
+		}
+		if now.Sub(j.FinishedAt) >= r.config.Retention {
+			delete(r.jobs, id)
+			n--
+			continue
+		}
+		finished = append(finished, j)
+	}
+	if n <= 0 {
+		return
+	}
+
+	sort.Slice(finished, func(i, k int) bool {
+		return finished[i].FinishedAt.Before(finished[k].FinishedAt)
+	})
+	for _, j := range finished[:min(n, len(finished))] {
+		delete(r.jobs, j.ID)
+	}
+}
+
+// reportQueued records the number of queued jobs, r.mu must be held.
+func (r *JobRepo) reportQueued() {
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(metric.Random_jobs_queued.Name) {
+		_ = metr.SetGauge(metric.Random_jobs_queued, float64(r.queued))
+	}
+}
@@ -0,0 +1,236 @@
+package random
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+func newTestJobRepository(queueSize, maxJobs int) *JobRepo {
+	registry, err := NewRegistry(&config.Random{DefaultAlgorithm: Legacy})
+	if err != nil {
+		panic(err)
+	}
+
+	return NewJobRepository(registry, &config.Jobs{
+		Workers:   1,
+		QueueSize: queueSize,
+		MaxJobs:   maxJobs,
+		Retention: time.Minute,
+	})
+}
+
+// runJobs starts the workers of repo until the test ends.
+func runJobs(t *testing.T, repo *JobRepo) {
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		repo.Run(stopCh)
+		close(done)
+	}()
+	t.Cleanup(func() {
+		close(stopCh)
+		<-done
+	})
+}
+
+func waitJob(t *testing.T, repo *JobRepo, id string, state entity.JobState) entity.Job {
+	var job entity.Job
+	assert.Eventually(t, func() bool {
+		var err error
+		job, err = repo.Get(context.Background(), id)
+		return err == nil && job.State == state
+	}, 5*time.Second, time.Millisecond, "Expected job %s to be %s", id, state)
+
+	return job
+}
+
+// endless draws enough samples to keep a worker busy until cancelled.
+var endless = entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: math.MaxInt64}
+
+func TestJobRepo_Run(t *testing.T) {
+	repo := newTestJobRepository(10, 10)
+	runJobs(t, repo)
+	ctx := context.Background()
+
+	job, err := repo.Submit(ctx, entity.JobSpec{Kind: entity.PiJob, Seed: 42, Algorithm: PCG, Samples: 100000})
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobQueued, job.State)
+	assert.Equal(t, PCG, job.Algorithm.Name)
+	assert.False(t, job.CreatedAt.IsZero())
+
+	job = waitJob(t, repo, job.ID, entity.JobSucceeded)
+	assert.Equal(t, int64(100000), job.Progress)
+	assert.InDelta(t, math.Pi, job.Result.Estimate, 4*job.Result.StdError)
+	assert.False(t, job.FinishedAt.Before(job.StartedAt))
+
+	failed, err := repo.Submit(ctx, entity.JobSpec{
+		Kind:       entity.IntegralJob,
+		Seed:       42,
+		Samples:    10,
+		Expression: "sqrt(x)",
+		Range:      entity.FloatRange{Min: -1, Max: 0},
+	})
+	assert.NoError(t, err)
+	failed = waitJob(t, repo, failed.ID, entity.JobFailed)
+	assert.Contains(t, failed.Error, "not a finite number")
+
+	_, err = repo.Submit(ctx, entity.JobSpec{Kind: entity.PiJob, Seed: 42, Algorithm: "mt19937", Samples: 1})
+	assert.ErrorContains(t, err, "validate")
+}
+
+func TestJobRepo_CancelQueued(t *testing.T) {
+	repo := newTestJobRepository(10, 10)
+	ctx := context.Background()
+
+	job, err := repo.Submit(ctx, entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 10})
+	assert.NoError(t, err)
+
+	cancelled, err := repo.Cancel(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobCancelled, cancelled.State)
+	assert.Zero(t, repo.queued)
+
+	again, err := repo.Cancel(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, again, "Expected cancelling a finished job to return it unchanged")
+
+	// The worker skips the job it dequeues
+	runJobs(t, repo)
+	next, err := repo.Submit(ctx, entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 10})
+	assert.NoError(t, err)
+	waitJob(t, repo, next.ID, entity.JobSucceeded)
+	job, err = repo.Get(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, cancelled, job)
+
+	_, err = repo.Cancel(ctx, "unknown")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+}
+
+func TestJobRepo_CancelRunning(t *testing.T) {
+	repo := newTestJobRepository(10, 10)
+	runJobs(t, repo)
+	ctx := context.Background()
+
+	job, err := repo.Submit(ctx, endless)
+	assert.NoError(t, err)
+	waitJob(t, repo, job.ID, entity.JobRunning)
+
+	cancelled, err := repo.Cancel(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobCancelled, cancelled.State)
+	assert.Equal(t, "cancelled by the client", cancelled.Error)
+
+	// The worker is free for the next job once the cancelled one stopped
+	next, err := repo.Submit(ctx, entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 10})
+	assert.NoError(t, err)
+	waitJob(t, repo, next.ID, entity.JobSucceeded)
+	job, err = repo.Get(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobCancelled, job.State)
+}
+
+func TestJobRepo_Shutdown(t *testing.T) {
+	repo := newTestJobRepository(10, 10)
+	ctx := context.Background()
+
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		repo.Run(stopCh)
+		close(done)
+	}()
+
+	job, err := repo.Submit(ctx, endless)
+	assert.NoError(t, err)
+	waitJob(t, repo, job.ID, entity.JobRunning)
+
+	close(stopCh)
+	<-done
+	job, err = repo.Get(ctx, job.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.JobCancelled, job.State)
+	assert.Contains(t, job.Error, "shutting down")
+}
+
+func TestJobRepo_Limits(t *testing.T) {
+	ctx := context.Background()
+	spec := entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 10}
+
+	t.Run("Queue", func(t *testing.T) {
+		repo := newTestJobRepository(1, 10)
+		_, err := repo.Submit(ctx, spec)
+		assert.NoError(t, err)
+		_, err = repo.Submit(ctx, spec)
+		assert.ErrorIs(t, err, grpc_errors.ErrExhausted)
+		assert.Len(t, repo.jobs, 1, "Expected rejected jobs not to be kept")
+	})
+
+	t.Run("Jobs", func(t *testing.T) {
+		repo := newTestJobRepository(10, 2)
+		first, err := repo.Submit(ctx, spec)
+		assert.NoError(t, err)
+		_, err = repo.Submit(ctx, spec)
+		assert.NoError(t, err)
+		_, err = repo.Submit(ctx, spec)
+		assert.ErrorIs(t, err, grpc_errors.ErrExhausted, "Expected active jobs never to be dropped")
+
+		// Finished jobs make room for new ones, even within their retention
+		_, err = repo.Cancel(ctx, first.ID)
+		assert.NoError(t, err)
+		_, err = repo.Submit(ctx, spec)
+		assert.NoError(t, err)
+		_, err = repo.Get(ctx, first.ID)
+		assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+	})
+}
+
+func TestJobRepo_RetentionAndList(t *testing.T) {
+	repo := newTestJobRepository(10, 10)
+	now := time.Unix(0, 0)
+	repo.now = func() time.Time {
+		return now
+	}
+	ctx := context.Background()
+	spec := entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 10}
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		job, err := repo.Submit(ctx, spec)
+		assert.NoError(t, err)
+		ids = append(ids, job.ID)
+		now = now.Add(time.Second)
+	}
+	_, err := repo.Cancel(ctx, ids[0])
+	assert.NoError(t, err)
+
+	jobs, err := repo.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 3)
+	for i, job := range jobs {
+		assert.Equal(t, ids[2-i], job.ID, "Expected the newest jobs first")
+	}
+
+	jobs, err = repo.List(ctx, entity.JobQueued)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2)
+	jobs, err = repo.List(ctx, entity.JobCancelled)
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 1)
+
+	now = now.Add(time.Minute)
+	repo.evict(0)
+	_, err = repo.Get(ctx, ids[0])
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound, "Expected finished jobs to be dropped after the retention")
+	jobs, err = repo.List(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, jobs, 2, "Expected active jobs to be kept")
+}
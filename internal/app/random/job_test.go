@@ -0,0 +1,116 @@
+package random
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   entity.JobSpec
+		expect float64
+	}{
+		{
+			name:   "Pi",
+			spec:   entity.JobSpec{Kind: entity.PiJob, Samples: 200000},
+			expect: math.Pi,
+		},
+		{
+			name: "Integral",
+			spec: entity.JobSpec{
+				Kind:       entity.IntegralJob,
+				Samples:    200000,
+				Expression: "x^2",
+				Range:      entity.FloatRange{Min: 0, Max: 3},
+			},
+			expect: 9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var progress []int64
+			result, err := estimate(context.Background(), rand.New(rand.NewSource(42)), tt.spec, func(done int64) {
+				progress = append(progress, done)
+			})
+			assert.NoError(t, err)
+			assert.Greater(t, result.StdError, 0.0)
+			assert.InDelta(t, tt.expect, result.Estimate, 4*result.StdError, "Expected the estimate within 4 standard errors")
+			assert.Equal(t, []int64{jobChunk, 2 * jobChunk, 3 * jobChunk, tt.spec.Samples}, progress)
+
+			again, err := estimate(context.Background(), rand.New(rand.NewSource(42)), tt.spec, func(int64) {})
+			assert.NoError(t, err)
+			assert.Equal(t, result, again, "Expected the same seed to produce the same estimate")
+		})
+	}
+}
+
+func TestEstimateNotFinite(t *testing.T) {
+	spec := entity.JobSpec{
+		Kind:       entity.IntegralJob,
+		Samples:    10,
+		Expression: "log(x)",
+		Range:      entity.FloatRange{Min: -2, Max: -1},
+	}
+	_, err := estimate(context.Background(), rand.New(rand.NewSource(42)), spec, func(int64) {})
+	assert.ErrorContains(t, err, "not a finite number")
+}
+
+func TestEstimateCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	spec := entity.JobSpec{Kind: entity.PiJob, Samples: 10 * jobChunk}
+	calls := 0
+	_, err := estimate(ctx, rand.New(rand.NewSource(42)), spec, func(int64) {
+		calls++
+		cancel()
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, calls, "Expected the job to stop after the chunk it was cancelled in")
+}
+
+func TestValidateJob(t *testing.T) {
+	integral := func(expression string, min, max float64) entity.JobSpec {
+		return entity.JobSpec{
+			Kind:       entity.IntegralJob,
+			Samples:    10,
+			Expression: expression,
+			Range:      entity.FloatRange{Min: min, Max: max},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		spec        entity.JobSpec
+		expectError bool
+	}{
+		{name: "Pi", spec: entity.JobSpec{Kind: entity.PiJob, Samples: 100}},
+		{name: "Integral", spec: integral("x", 0, 1)},
+		{name: "No Samples", spec: entity.JobSpec{Kind: entity.PiJob}, expectError: true},
+		{name: "Too Many Samples", spec: entity.JobSpec{Kind: entity.PiJob, Samples: 101}, expectError: true},
+		{name: "Pi With Expression", spec: entity.JobSpec{Kind: entity.PiJob, Samples: 1, Expression: "x"}, expectError: true},
+		{name: "Empty Range", spec: integral("x", 1, 1), expectError: true},
+		{name: "Inverted Range", spec: integral("x", 1, 0), expectError: true},
+		{name: "Infinite Width", spec: integral("x", -math.MaxFloat64, math.MaxFloat64), expectError: true},
+		{name: "NaN Bound", spec: integral("x", math.NaN(), 1), expectError: true},
+		{name: "Invalid Expression", spec: integral("x +", 0, 1), expectError: true},
+		{name: "Unknown Kind", spec: entity.JobSpec{Kind: "e", Samples: 1}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateJob(tt.spec, 100)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package random
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/log"
+)
+
+// journaledMethods are the methods whose replies are draws.
+var journaledMethods = map[string]bool{
+	"GetRandNumber":        true,
+	"StreamRandNumbers":    true,
+	"GetRandNumbers":       true,
+	"GetRandNumberInRange": true,
+	"WeightedChoice":       true,
+	"Roll":                 true,
+	"SampleDistribution":   true,
+	"GetRandBytes":         true,
+	"GetUUIDs":             true,
+	"GetToken":             true,
+	"GetRandStrings":       true,
+	"GenerateRecords":      true,
+	"Shuffle":              true,
+	"GetRandNumberAt":      true,
+	"Next":                 true,
+	"DrawSession":          true,
+	"Draw":                 true,
+}
+
+// DrawJournal records the draws served over gRPC in the draw history. Its
+// interceptors wrap the handlers and save a draw for every successful reply,
+// or every message of a stream.
+type DrawJournal struct {
+	draws    entity.IDrawRepository
+	registry *Registry
+	secure   entity.Algorithm
+	now      func() time.Time
+}
+
+func NewDrawJournal(draws entity.IDrawRepository, registry *Registry) *DrawJournal {
+	return &DrawJournal{
+		draws:    draws,
+		registry: registry,
+		secure:   NewSecureRepository().Algorithm(),
+		now:      time.Now,
+	}
+}
+
+// Unary records the reply of draws.
+func (d *DrawJournal) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	reply, err := handler(ctx, req)
+	if err == nil && d.journaled(info.FullMethod) {
+		d.record(ctx, info.FullMethod, req, reply)
+	}
+
+	return reply, err
+}
+
+// Stream records every message draw streams send.
+func (d *DrawJournal) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !d.journaled(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &journaledStream{
+		ServerStream: ss,
+		journal:      d,
+		method:       info.FullMethod,
+	})
+}
+
+func (d *DrawJournal) journaled(fullMethod string) bool {
+	_, method := grpcUtils.SplitMethodName(fullMethod)
+	return journaledMethods[method]
+}
+
+func (d *DrawJournal) record(ctx context.Context, method string, request, reply interface{}) {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return
+	}
+	output, err := protojson.Marshal(message)
+	if err != nil {
+		log.GetLogger().Errorf("failed to journal a reply of %s: %v", method, err)
+		return
+	}
+
+	seed, algorithm := d.origin(request, reply)
+	err = d.draws.Save(ctx, entity.Draw{
+		Timestamp: d.now(),
+		RequestID: grpcUtils.GetRequestIDFromContext(ctx),
+		ClientIP:  grpcUtils.GetClientIPFromContext(ctx),
+		Method:    method,
+		Seed:      seed,
+		Algorithm: algorithm,
+		Output:    output,
+	})
+	if err != nil {
+		log.GetLogger().Errorf("failed to journal a reply of %s: %v", method, err)
+	}
+}
+
+// origin returns the seed and the algorithm of a draw. The seed is 0 when
+// secure or when the request has no single seed, and the algorithm is empty
+// when the request does not select one, e.g. for generators.
+func (d *DrawJournal) origin(request, reply interface{}) (int64, entity.Algorithm) {
+	if r, ok := request.(interface{ GetMode() pb.Mode }); ok && r.GetMode() == pb.Mode_MODE_SECURE {
+		return 0, d.secure
+	}
+
+	var seed int64
+	if r, ok := request.(interface{ GetSeedNum() int64 }); ok {
+		seed = r.GetSeedNum()
+	}
+
+	if r, ok := reply.(interface {
+		GetAlgorithm() string
+		GetAlgorithmVersion() string
+	}); ok && r.GetAlgorithm() != "" {
+		return seed, entity.Algorithm{Name: r.GetAlgorithm(), Version: r.GetAlgorithmVersion()}
+	}
+	r, ok := request.(interface{ GetAlgorithm() string })
+	if !ok {
+		return seed, entity.Algorithm{}
+	}
+	repo, err := d.registry.repo(r.GetAlgorithm())
+	if err != nil {
+		return seed, entity.Algorithm{Name: r.GetAlgorithm()}
+	}
+
+	return seed, repo.Algorithm()
+}
+
+// journaledStream records the messages sent on a stream along with the
+// request they answer.
+type journaledStream struct {
+	grpc.ServerStream
+	journal *DrawJournal
+	method  string
+	// request is the last message received, or the last reseed command of a
+	// draw session
+	request interface{}
+}
+
+func (s *journaledStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	if command, ok := m.(*pb.DrawCommand); ok {
+		if reseed := command.GetReseed(); reseed != nil {
+			s.request = reseed
+		}
+	} else {
+		s.request = m
+	}
+
+	return nil
+}
+
+func (s *journaledStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.journal.record(s.Context(), s.method, s.request, m)
+	}
+
+	return err
+}
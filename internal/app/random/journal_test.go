@@ -0,0 +1,105 @@
+package random
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/journal"
+)
+
+func TestDrawJournal(t *testing.T) {
+	journalConfig := &config.Journal{
+		Enabled:    true,
+		Path:       filepath.Join(t.TempDir(), "journal.log"),
+		BufferSize: 10,
+	}
+	j, err := journal.Open(journalConfig)
+	assert.NoError(t, err)
+	go j.Run()
+
+	registry, err := NewRegistry(&config.Random{DefaultAlgorithm: Legacy})
+	assert.NoError(t, err)
+	drawJournal := NewDrawJournal(NewDrawRepository(j), registry)
+	randClient := newTestClient(t,
+		grpc.ChainUnaryInterceptor(drawJournal.Unary),
+		grpc.ChainStreamInterceptor(drawJournal.Stream),
+	)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		grpcUtils.ClientIPHeader, "192.0.2.1",
+		grpcUtils.RequestIDHeader, "request-1",
+	)
+
+	reply, err := randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 42, Algorithm: PCG})
+	assert.NoError(t, err)
+	_, err = randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE})
+	assert.NoError(t, err)
+	// Failed calls and calls that draw nothing are not journaled
+	_, err = randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 1})
+	assert.Error(t, err)
+	_, err = randClient.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.Error(t, err)
+
+	stream, err := randClient.DrawSession(ctx)
+	assert.NoError(t, err)
+	for _, command := range []*pb.DrawCommand{
+		{ID: 1, Command: &pb.DrawCommand_Reseed{Reseed: &pb.ReseedCommand{SeedNum: 7}}},
+		{ID: 2, Command: &pb.DrawCommand_NextInt{NextInt: &pb.NextIntCommand{Count: 2}}},
+	} {
+		assert.NoError(t, stream.Send(command))
+		_, err := stream.Recv()
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, stream.CloseSend())
+
+	assert.Eventually(t, func() bool {
+		data, err := os.ReadFile(journalConfig.Path)
+		return err == nil && strings.Count(string(data), "\n") == 4
+	}, 5*time.Second, time.Millisecond, "Expected 4 journaled draws")
+	assert.NoError(t, j.Close())
+
+	file, err := os.Open(journalConfig.Path)
+	assert.NoError(t, err)
+	defer file.Close()
+	summary, err := journal.Verify(file)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), summary.Entries)
+
+	data, err := os.ReadFile(journalConfig.Path)
+	assert.NoError(t, err)
+	var entries []journal.Entry
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var entry journal.Entry
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+
+	assert.Equal(t, "/random.RandomService/GetRandNumber", entries[0].Method)
+	assert.Equal(t, "request-1", entries[0].RequestID)
+	assert.Equal(t, "192.0.2.1", entries[0].ClientIP)
+	assert.Equal(t, int64(42), entries[0].Seed)
+	assert.Equal(t, PCG, entries[0].Algorithm)
+	assert.Contains(t, string(entries[0].Output), `"Number":"`+strconv.FormatInt(reply.GetNumber(), 10)+`"`)
+
+	assert.Equal(t, int64(0), entries[1].Seed)
+	assert.Equal(t, "crypto/rand", entries[1].Algorithm)
+
+	for _, entry := range entries[2:] {
+		assert.Equal(t, "/random.RandomService/DrawSession", entry.Method)
+		assert.Equal(t, int64(7), entry.Seed)
+		assert.Equal(t, Legacy, entry.Algorithm, "Expected draws after a reseed to keep its seed and algorithm")
+	}
+}
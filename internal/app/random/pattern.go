@@ -0,0 +1,299 @@
+package random
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+const (
+	// maxPatternLength is the longest pattern or charset accepted.
+	maxPatternLength = 256
+	// maxPatternRepeat is the largest repetition of a single part.
+	maxPatternRepeat = 256
+	// maxPatternStringLength is the longest string a pattern may produce.
+	maxPatternStringLength = 1024
+)
+
+const (
+	digits = "0123456789"
+	word   = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ_abcdefghijklmnopqrstuvwxyz"
+)
+
+// parsePattern parses a restricted regular expression, limited to printable
+// ASCII characters:
+//
+//	pattern    = {atom [quantifier]}
+//	atom       = literal | "\" escape | "[" charset "]"
+//	charset    = {char ["-" char]}
+//	quantifier = "{" number ["," number] "}" | "?"
+//
+// \d matches a digit, \w a letter, a digit or an underscore, and "\"
+// followed by any other punctuation matches that character. Unbounded
+// repetitions such as "*" and "+" are not supported.
+func parsePattern(pattern string) (entity.Pattern, error) {
+	p := patternParser{s: pattern}
+	return p.parse()
+}
+
+// parseCharset parses a charset specification, the inside of a character
+// class such as "A-Z0-9_", into a pattern of length characters.
+func parseCharset(spec string, length int) (entity.Pattern, error) {
+	if length < 1 || length > maxPatternStringLength {
+		return entity.Pattern{}, fmt.Errorf("validate: length must be between 1 and %d", maxPatternStringLength)
+	}
+
+	p := patternParser{s: spec}
+	if err := p.validate(); err != nil {
+		return entity.Pattern{}, err
+	}
+	charset, err := p.charset(0)
+	if err != nil {
+		return entity.Pattern{}, err
+	}
+
+	return entity.Pattern{
+		Parts: []entity.PatternPart{{Charset: charset, Min: length, Max: length}},
+	}, nil
+}
+
+type patternParser struct {
+	s   string
+	pos int
+}
+
+func (p *patternParser) parse() (entity.Pattern, error) {
+	var pattern entity.Pattern
+	if p.s == "" {
+		return pattern, errors.New("validate: pattern is empty")
+	}
+	if err := p.validate(); err != nil {
+		return pattern, err
+	}
+
+	length := 0
+	for p.pos < len(p.s) {
+		part, err := p.atom()
+		if err != nil {
+			return pattern, err
+		}
+		if err := p.quantifier(&part); err != nil {
+			return pattern, err
+		}
+
+		length += part.Max
+		if length > maxPatternStringLength {
+			return pattern, fmt.Errorf("validate: pattern %q produces strings longer than %d characters", p.s, maxPatternStringLength)
+		}
+		pattern.Parts = append(pattern.Parts, part)
+	}
+
+	return pattern, nil
+}
+
+// validate checks the length and the characters of the whole input.
+func (p *patternParser) validate() error {
+	if len(p.s) > maxPatternLength {
+		return fmt.Errorf("validate: pattern is longer than %d characters", maxPatternLength)
+	}
+	for i := 0; i < len(p.s); i++ {
+		if p.s[i] < ' ' || p.s[i] > '~' {
+			return fmt.Errorf("validate: only printable ASCII characters are supported, found %q at position %d", p.s[i], i+1)
+		}
+	}
+
+	return nil
+}
+
+func (p *patternParser) atom() (entity.PatternPart, error) {
+	part := entity.PatternPart{Min: 1, Max: 1}
+
+	switch c := p.s[p.pos]; c {
+	case '[':
+		p.pos++
+		charset, err := p.charset(']')
+		if err != nil {
+			return part, err
+		}
+		part.Charset = charset
+	case '\\':
+		charset, err := p.escape()
+		if err != nil {
+			return part, err
+		}
+		part.Charset = charset
+	case '*', '+':
+		return part, p.errorf("unbounded repetition is not supported, use {n,m}")
+	case ']', '{', '}', '?', '(', ')', '|', '.', '^', '$':
+		return part, p.errorf(fmt.Sprintf("unexpected %q, escape it with \\", c))
+	default:
+		p.pos++
+		part.Charset = string(c)
+	}
+
+	return part, nil
+}
+
+// charset parses characters and ranges until end, or the end of the input
+// when end is 0, and returns them sorted without duplicates.
+func (p *patternParser) charset(end byte) (string, error) {
+	var set [128]bool
+	empty := true
+	for {
+		if p.pos == len(p.s) {
+			if end != 0 {
+				return "", p.errorf(fmt.Sprintf("expected %q", end))
+			}
+			break
+		}
+		if p.s[p.pos] == end {
+			p.pos++
+			break
+		}
+
+		lo, class, err := p.char()
+		if err != nil {
+			return "", err
+		}
+		if class != "" {
+			for i := 0; i < len(class); i++ {
+				set[class[i]] = true
+			}
+			empty = false
+			continue
+		}
+
+		hi := lo
+		if p.pos+1 < len(p.s) && p.s[p.pos] == '-' && p.s[p.pos+1] != end {
+			p.pos++
+			hi, class, err = p.char()
+			if err != nil {
+				return "", err
+			}
+			if class != "" || hi < lo {
+				return "", p.errorf(fmt.Sprintf("invalid range %q-%q", lo, hi))
+			}
+		}
+		for c := lo; c <= hi; c++ {
+			set[c] = true
+		}
+		empty = false
+	}
+
+	if empty {
+		return "", p.errorf("expected at least one character in the charset")
+	}
+
+	var b strings.Builder
+	for c := range set {
+		if set[c] {
+			b.WriteByte(byte(c))
+		}
+	}
+
+	return b.String(), nil
+}
+
+// char parses a character of a charset, escapes of classes such as \d return
+// the whole class instead.
+func (p *patternParser) char() (byte, string, error) {
+	if p.s[p.pos] != '\\' {
+		p.pos++
+		return p.s[p.pos-1], "", nil
+	}
+
+	charset, err := p.escape()
+	if err != nil {
+		return 0, "", err
+	}
+	if len(charset) > 1 {
+		return 0, charset, nil
+	}
+
+	return charset[0], "", nil
+}
+
+func (p *patternParser) escape() (string, error) {
+	p.pos++
+	if p.pos == len(p.s) {
+		return "", p.errorf("expected an escaped character")
+	}
+
+	c := p.s[p.pos]
+	p.pos++
+	switch {
+	case c == 'd':
+		return digits, nil
+	case c == 'w':
+		return word, nil
+	case c == ' ' || strings.IndexByte(`!"#$%&'()*+,-./:;<=>?@[\]^_{|}~`+"`", c) >= 0:
+		return string(c), nil
+	}
+
+	p.pos--
+	return "", p.errorf(fmt.Sprintf("unknown escape \\%c", c))
+}
+
+func (p *patternParser) quantifier(part *entity.PatternPart) error {
+	if p.pos == len(p.s) {
+		return nil
+	}
+
+	switch p.s[p.pos] {
+	case '?':
+		p.pos++
+		part.Min, part.Max = 0, 1
+	case '{':
+		p.pos++
+		n, err := p.number()
+		if err != nil {
+			return err
+		}
+		m := n
+		if p.pos < len(p.s) && p.s[p.pos] == ',' {
+			p.pos++
+			if m, err = p.number(); err != nil {
+				return err
+			}
+		}
+		if p.pos == len(p.s) || p.s[p.pos] != '}' {
+			return p.errorf("expected }")
+		}
+		p.pos++
+
+		if m < n {
+			return fmt.Errorf("validate: repetition {%d,%d} has a maximum below its minimum", n, m)
+		}
+		part.Min, part.Max = n, m
+	}
+
+	return nil
+}
+
+// number parses a repetition count.
+func (p *patternParser) number() (int, error) {
+	start := p.pos
+	n := 0
+	for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+		n = n*10 + int(p.s[p.pos]-'0')
+		p.pos++
+		if n > maxPatternRepeat {
+			return 0, fmt.Errorf("validate: repetitions are limited to %d", maxPatternRepeat)
+		}
+	}
+	if p.pos == start {
+		return 0, p.errorf("expected a number")
+	}
+
+	return n, nil
+}
+
+func (p *patternParser) errorf(expected string) error {
+	if p.pos >= len(p.s) {
+		return fmt.Errorf("validate: %s at the end of %q", expected, p.s)
+	}
+
+	return fmt.Errorf("validate: %s at position %d of %q", expected, p.pos+1, p.s)
+}
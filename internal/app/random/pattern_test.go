@@ -0,0 +1,105 @@
+package random
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestParsePattern(t *testing.T) {
+	upper := "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	tests := []struct {
+		name        string
+		pattern     string
+		expect      entity.Pattern
+		expectError bool
+	}{
+		{
+			name:    "License Key",
+			pattern: "[A-Z]{3}-[0-9]{4}",
+			expect: entity.Pattern{Parts: []entity.PatternPart{
+				{Charset: upper, Min: 3, Max: 3},
+				{Charset: "-", Min: 1, Max: 1},
+				{Charset: digits, Min: 4, Max: 4},
+			}},
+		},
+		{
+			name:    "Classes Ranges And Optional",
+			pattern: `\w{2,5}[a-c\d_]?`,
+			expect: entity.Pattern{Parts: []entity.PatternPart{
+				{Charset: word, Min: 2, Max: 5},
+				{Charset: digits + "_abc", Min: 0, Max: 1},
+			}},
+		},
+		{
+			name:    "Escapes And Trailing Dash",
+			pattern: `\[\.[x-]`,
+			expect: entity.Pattern{Parts: []entity.PatternPart{
+				{Charset: "[", Min: 1, Max: 1},
+				{Charset: ".", Min: 1, Max: 1},
+				{Charset: "-x", Min: 1, Max: 1},
+			}},
+		},
+		{
+			name:    "Duplicates Are Merged",
+			pattern: "[aa-cb]{0}",
+			expect: entity.Pattern{Parts: []entity.PatternPart{
+				{Charset: "abc", Min: 0, Max: 0},
+			}},
+		},
+		{name: "Empty", pattern: "", expectError: true},
+		{name: "Unclosed Class", pattern: "[A-Z", expectError: true},
+		{name: "Empty Class", pattern: "[]", expectError: true},
+		{name: "Reversed Range", pattern: "[z-a]", expectError: true},
+		{name: "Class In Range", pattern: `[a-\d]`, expectError: true},
+		{name: "Unbounded", pattern: "a+", expectError: true},
+		{name: "Star", pattern: "a*", expectError: true},
+		{name: "Dot", pattern: "a.b", expectError: true},
+		{name: "Group", pattern: "(ab)", expectError: true},
+		{name: "Unknown Escape", pattern: `\q`, expectError: true},
+		{name: "Dangling Escape", pattern: `a\`, expectError: true},
+		{name: "Unclosed Quantifier", pattern: "a{3", expectError: true},
+		{name: "Missing Count", pattern: "a{,3}", expectError: true},
+		{name: "Reversed Quantifier", pattern: "a{3,1}", expectError: true},
+		{name: "Repeat Too Large", pattern: "a{257}", expectError: true},
+		{name: "String Too Long", pattern: "a{256}b{256}c{256}d{256}e", expectError: true},
+		{name: "Pattern Too Long", pattern: strings.Repeat("a", maxPatternLength+1), expectError: true},
+		{name: "Non ASCII", pattern: "é{3}", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pattern, err := parsePattern(tt.pattern)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expect, pattern)
+		})
+	}
+}
+
+func TestParseCharset(t *testing.T) {
+	pattern, err := parseCharset("A-F0-9", 8)
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Pattern{Parts: []entity.PatternPart{
+		{Charset: "0123456789ABCDEF", Min: 8, Max: 8},
+	}}, pattern)
+
+	// Brackets need no escape outside of a class
+	pattern, err = parseCharset("[]", 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "[]", pattern.Parts[0].Charset)
+
+	_, err = parseCharset("", 8)
+	assert.ErrorContains(t, err, "validate")
+	_, err = parseCharset("a-z", 0)
+	assert.ErrorContains(t, err, "validate")
+	_, err = parseCharset("a-z", maxPatternStringLength+1)
+	assert.ErrorContains(t, err, "validate")
+}
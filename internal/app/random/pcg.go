@@ -0,0 +1,90 @@
+package random
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// Multiplier and increment of the 128-bit LCG driving math/rand/v2.PCG.
+var (
+	pcgMul = uint128{hi: 2549297995355413924, lo: 4865540595714422341}
+	pcgInc = uint128{hi: 6364136223846793005, lo: 1442695040888963407}
+)
+
+type uint128 struct {
+	hi uint64
+	lo uint64
+}
+
+func (a uint128) mul(b uint128) uint128 {
+	hi, lo := bits.Mul64(a.lo, b.lo)
+	hi += a.hi*b.lo + a.lo*b.hi
+	return uint128{hi: hi, lo: lo}
+}
+
+func (a uint128) add(b uint128) uint128 {
+	lo, c := bits.Add64(a.lo, b.lo, 0)
+	hi, _ := bits.Add64(a.hi, b.hi, c)
+	return uint128{hi: hi, lo: lo}
+}
+
+// newPCG returns the PCG for seed, both state words are derived from
+// the seed with SplitMix64 so that close seeds give unrelated sequences.
+func newPCG(seed int64) *rand.PCG {
+	s := uint64(seed)
+	return rand.NewPCG(splitMix64(&s), splitMix64(&s))
+}
+
+// pcgJump advances pcg by delta steps in O(log delta), using Brown's
+// "Random Number Generation with Arbitrary Strides" to compose the LCG steps.
+func pcgJump(pcg *rand.PCG, delta uint64) error {
+	b, err := pcg.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	state := uint128{
+		hi: binary.BigEndian.Uint64(b[4:12]),
+		lo: binary.BigEndian.Uint64(b[12:20]),
+	}
+
+	accMul, accInc := uint128{lo: 1}, uint128{}
+	curMul, curInc := pcgMul, pcgInc
+	for ; delta > 0; delta >>= 1 {
+		if delta&1 == 1 {
+			accMul = accMul.mul(curMul)
+			accInc = accInc.mul(curMul).add(curInc)
+		}
+		curInc = curMul.add(uint128{lo: 1}).mul(curInc)
+		curMul = curMul.mul(curMul)
+	}
+	state = accMul.mul(state).add(accInc)
+
+	binary.BigEndian.PutUint64(b[4:12], state.hi)
+	binary.BigEndian.PutUint64(b[12:20], state.lo)
+	return pcg.UnmarshalBinary(b)
+}
+
+// splitMix64 returns the next output of the SplitMix64 generator with state s.
+func splitMix64(s *uint64) uint64 {
+	*s += 0x9e3779b97f4a7c15
+	z := *s
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return z ^ (z >> 31)
+}
+
+// pcgValues returns count values of the PCG sequence of seed starting at index.
+func pcgValues(seed int64, index uint64, count int) ([]int64, error) {
+	pcg := newPCG(seed)
+	if err := pcgJump(pcg, index); err != nil {
+		return nil, err
+	}
+
+	values := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		values = append(values, int64(pcg.Uint64()&^(1<<63)))
+	}
+
+	return values, nil
+}
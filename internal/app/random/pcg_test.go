@@ -0,0 +1,42 @@
+package random
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPCGJump_MatchesStepping(t *testing.T) {
+	stepped := newPCG(42)
+	for i := uint64(0); i < 2000; i++ {
+		want := stepped.Uint64()
+		if i%97 != 0 {
+			continue
+		}
+
+		jumped := newPCG(42)
+		assert.NoError(t, pcgJump(jumped, i))
+		assert.Equal(t, want, jumped.Uint64(), "Expected the jump to land on position %d", i)
+	}
+}
+
+func TestPCGValues(t *testing.T) {
+	all, err := pcgValues(42, 0, 30)
+	assert.NoError(t, err)
+
+	slice, err := pcgValues(42, 10, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, all[10:], slice, "Expected disjoint slices of the same sequence")
+
+	for _, v := range all {
+		assert.GreaterOrEqual(t, v, int64(0))
+	}
+
+	// Jumps compose, far positions are reachable in both one and several jumps
+	far := newPCG(42)
+	assert.NoError(t, pcgJump(far, 1<<40+5))
+	split := newPCG(42)
+	assert.NoError(t, pcgJump(split, 1<<40))
+	assert.NoError(t, pcgJump(split, 5))
+	assert.Equal(t, far.Uint64(), split.Uint64())
+}
@@ -0,0 +1,434 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/log"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+)
+
+// anonymousClient is the metric label of clients not listed in the config,
+// which are counted by IP.
+const anonymousClient = "anonymous"
+
+// quotaClient is a client and its limits.
+type quotaClient struct {
+	// id keys the usage of the client
+	id   string
+	name string
+	tier string
+	// label is the name of the client in metrics
+	label string
+	// limits are 0 for windows without a quota
+	limits map[entity.QuotaWindow]int64
+}
+
+// quotaWindow is the current window of a quota.
+type quotaWindow struct {
+	window   entity.QuotaWindow
+	key      string
+	limit    int64
+	resetsAt time.Time
+}
+
+// Quotas limits the draws of every client per day and per month. Clients are
+// identified by their API key, or by their IP when they send none, and every
+// successful reply of a draw method, or every message of a draw stream holding
+// drawn values, counts as a draw. Windows start at midnight UTC, on the first of the month for the
+// monthly quota.
+type Quotas struct {
+	config *config.Quotas
+	usage  entity.IUsageRepository
+	now    func() time.Time
+
+	tiers   map[string]config.QuotaTier
+	keys    map[string]quotaClient
+	clients map[string]quotaClient
+
+	mu sync.Mutex
+	// anonymous are the IDs of the anonymous clients counted in the current
+	// windows, and when their last window resets
+	anonymous map[string]time.Time
+}
+
+func NewQuotas(config *config.Quotas, usage entity.IUsageRepository) (*Quotas, error) {
+	q := &Quotas{
+		config:    config,
+		usage:     usage,
+		now:       time.Now,
+		keys:      make(map[string]quotaClient),
+		clients:   make(map[string]quotaClient),
+		anonymous: make(map[string]time.Time),
+	}
+	if !config.Enabled {
+		return q, nil
+	}
+
+	tiers, err := tiersByName(config.Tiers)
+	if err != nil {
+		return nil, err
+	}
+	q.tiers = tiers
+	if _, ok := q.tiers[config.DefaultTier]; !ok {
+		return nil, fmt.Errorf("unknown default quota tier %q", config.DefaultTier)
+	}
+
+	names := make(map[string]bool)
+	for _, c := range config.Clients {
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicate quota client %q", c.Name)
+		}
+		names[c.Name] = true
+
+		tierName := c.Tier
+		if tierName == "" {
+			tierName = config.DefaultTier
+		}
+		tier, ok := q.tiers[tierName]
+		if !ok {
+			return nil, fmt.Errorf("unknown quota tier %q of client %q", tierName, c.Name)
+		}
+		client := quotaClient{
+			id:    "client:" + c.Name,
+			name:  c.Name,
+			tier:  tierName,
+			label: c.Name,
+			limits: map[entity.QuotaWindow]int64{
+				entity.Daily:   orDefault(c.Daily, tier.Daily),
+				entity.Monthly: orDefault(c.Monthly, tier.Monthly),
+			},
+		}
+
+		if c.APIKey != "" {
+			if _, ok := q.keys[c.APIKey]; ok {
+				return nil, fmt.Errorf("duplicate API key of quota client %q", c.Name)
+			}
+			q.keys[c.APIKey] = client
+		}
+		if c.ClientIP != "" {
+			if _, ok := q.clients[c.ClientIP]; ok {
+				return nil, fmt.Errorf("duplicate client IP of quota client %q", c.Name)
+			}
+			q.clients[c.ClientIP] = client
+		}
+	}
+
+	return q, nil
+}
+
+// Usage returns the consumption of the client calling with ctx.
+func (q *Quotas) Usage(ctx context.Context) (entity.Usage, error) {
+	if !q.config.Enabled {
+		return entity.Usage{}, fmt.Errorf("quotas are not enabled on this server: %w", grpc_errors.ErrDisabled)
+	}
+	client, err := q.client(ctx)
+	if err != nil {
+		return entity.Usage{}, err
+	}
+
+	usage := entity.Usage{
+		Client: client.name,
+		Tier:   client.tier,
+	}
+	for _, w := range client.windows(q.now()) {
+		used, err := q.usage.Get(ctx, w.key)
+		if err != nil {
+			return entity.Usage{}, err
+		}
+		usage.Quotas = append(usage.Quotas, entity.QuotaUsage{
+			Window:   w.window,
+			Used:     used,
+			Limit:    w.limit,
+			ResetsAt: w.resetsAt,
+		})
+	}
+
+	return usage, nil
+}
+
+// Unary counts the draws of unary calls, and rejects them once a quota of
+// the client is used up.
+func (q *Quotas) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !q.counted(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	client, err := q.client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	windows, err := q.reserve(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := handler(ctx, req)
+	if err != nil {
+		q.release(ctx, windows)
+	} else {
+		q.count(metric.Random_quota_draws_total, client)
+	}
+
+	return reply, err
+}
+
+// Stream counts every message draw streams send, and ends them once a quota
+// of the client is used up.
+func (q *Quotas) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !q.counted(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	client, err := q.client(ss.Context())
+	if err != nil {
+		return err
+	}
+
+	return handler(srv, &quotaStream{
+		ServerStream: ss,
+		quotas:       q,
+		client:       client,
+	})
+}
+
+func (q *Quotas) counted(fullMethod string) bool {
+	_, method := grpcUtils.SplitMethodName(fullMethod)
+	return journaledMethods[method]
+}
+
+// client returns the client calling with ctx. Unknown API keys are rejected
+// rather than counted apart, clients could otherwise get a fresh quota with
+// every key they make up.
+func (q *Quotas) client(ctx context.Context) (quotaClient, error) {
+	if key := grpcUtils.GetAPIKeyFromContext(ctx); key != "" {
+		client, ok := q.keys[key]
+		if !ok {
+			return quotaClient{}, fmt.Errorf("unknown API key: %w", grpc_errors.ErrUnauthenticated)
+		}
+		return client, nil
+	}
+
+	ip := clientIP(ctx)
+	if client, ok := q.clients[ip]; ok {
+		return client, nil
+	}
+	tier := q.tiers[q.config.DefaultTier]
+
+	return quotaClient{
+		id:    "ip:" + ip,
+		name:  ip,
+		tier:  tier.Name,
+		label: anonymousClient,
+		limits: map[entity.QuotaWindow]int64{
+			entity.Daily:   tier.Daily,
+			entity.Monthly: tier.Monthly,
+		},
+	}, nil
+}
+
+// reserve counts a draw of client, or returns a ResourceExhausted error with
+// the time the quota resets when it would exceed one. When several would be
+// exceeded, the error tells the latest reset. It returns the windows the draw
+// was counted in.
+func (q *Quotas) reserve(ctx context.Context, client quotaClient) ([]quotaWindow, error) {
+	now := q.now()
+	windows := client.windows(now)
+	if err := q.admit(client, windows, now); err != nil {
+		return nil, err
+	}
+	var exceeded *quotaWindow
+	for i, w := range windows {
+		used, err := q.usage.Add(ctx, w.key, 1, w.resetsAt)
+		if err != nil {
+			q.release(ctx, windows[:i])
+			return nil, err
+		}
+		if w.limit > 0 && used > w.limit {
+			exceeded = &windows[i]
+		}
+	}
+	if exceeded != nil {
+		q.release(ctx, windows)
+		q.count(metric.Random_quota_rejected_total, client, string(exceeded.window))
+		return nil, quotaError(*exceeded, now)
+	}
+
+	return windows, nil
+}
+
+// admit keeps track of the anonymous clients counted in windows, and rejects
+// new ones once there are MaxAnonymousClients, so that clients changing their
+// IP cannot grow the usage kept without bounds.
+func (q *Quotas) admit(client quotaClient, windows []quotaWindow, now time.Time) error {
+	if client.label != anonymousClient {
+		return nil
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if resetsAt, ok := q.anonymous[client.id]; ok && now.Before(resetsAt) {
+		return nil
+	}
+	if len(q.anonymous) >= q.config.MaxAnonymousClients {
+		for id, resetsAt := range q.anonymous {
+			if !now.Before(resetsAt) {
+				delete(q.anonymous, id)
+			}
+		}
+	}
+	if len(q.anonymous) >= q.config.MaxAnonymousClients {
+		return fmt.Errorf("too many clients without an API key, retry next month or send one: %w", grpc_errors.ErrExhausted)
+	}
+
+	var resetsAt time.Time
+	for _, w := range windows {
+		if w.resetsAt.After(resetsAt) {
+			resetsAt = w.resetsAt
+		}
+	}
+	q.anonymous[client.id] = resetsAt
+
+	return nil
+}
+
+// release uncounts a draw reserved in windows, for draws that failed.
+func (q *Quotas) release(ctx context.Context, windows []quotaWindow) {
+	for _, w := range windows {
+		if _, err := q.usage.Add(ctx, w.key, -1, w.resetsAt); err != nil {
+			log.GetLogger().Errorf("failed to release a draw of %s: %v", w.key, err)
+		}
+	}
+}
+
+func (q *Quotas) count(m *metric.Metric, client quotaClient, labels ...string) {
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(m.Name) {
+		_ = metr.Counter(m, 1, append([]string{client.label, client.tier}, labels...)...)
+	}
+}
+
+// windows returns the quota windows of client at now.
+func (c quotaClient) windows(now time.Time) []quotaWindow {
+	now = now.UTC()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	return []quotaWindow{
+		{
+			window:   entity.Daily,
+			key:      c.id + "/daily/" + day.Format(time.DateOnly),
+			limit:    c.limits[entity.Daily],
+			resetsAt: day.AddDate(0, 0, 1),
+		},
+		{
+			window:   entity.Monthly,
+			key:      c.id + "/monthly/" + month.Format("2006-01"),
+			limit:    c.limits[entity.Monthly],
+			resetsAt: month.AddDate(0, 1, 0),
+		},
+	}
+}
+
+// quotaError returns the error of a draw exceeding the quota of w, which
+// tells clients when to retry.
+func quotaError(w quotaWindow, now time.Time) error {
+	st := status.New(codes.ResourceExhausted, fmt.Sprintf("%s quota of %d draws exceeded, resets at %s",
+		w.window, w.limit, w.resetsAt.Format(time.RFC3339)))
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(w.resetsAt.Sub(now)),
+	})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// clientIP returns the IP of the client calling with ctx, as set by
+// middleware.ClientIP, or the address of the peer when it did not run.
+func clientIP(ctx context.Context) string {
+	if ip := grpcUtils.GetClientIPFromContext(ctx); ip != "" {
+		return ip
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+func tiersByName(tiers []config.QuotaTier) (map[string]config.QuotaTier, error) {
+	byName := make(map[string]config.QuotaTier, len(tiers))
+	for _, tier := range tiers {
+		if _, ok := byName[tier.Name]; ok {
+			return nil, fmt.Errorf("duplicate quota tier %q", tier.Name)
+		}
+		byName[tier.Name] = tier
+	}
+
+	return byName, nil
+}
+
+func orDefault(value, defaultValue int64) int64 {
+	if value == 0 {
+		return defaultValue
+	}
+
+	return value
+}
+
+// quotaStream counts the messages sent on a draw stream.
+type quotaStream struct {
+	grpc.ServerStream
+	quotas *Quotas
+	client quotaClient
+}
+
+func (s *quotaStream) SendMsg(m interface{}) error {
+	if !holdsDraw(m) {
+		return s.ServerStream.SendMsg(m)
+	}
+	windows, err := s.quotas.reserve(s.Context(), s.client)
+	if err != nil {
+		return err
+	}
+
+	err = s.ServerStream.SendMsg(m)
+	if err != nil {
+		s.quotas.release(s.Context(), windows)
+	} else {
+		s.quotas.count(metric.Random_quota_draws_total, s.client)
+	}
+
+	return err
+}
+
+// holdsDraw reports whether m, a message of a draw stream, carries drawn
+// values. DrawSession answers reseeds and skips with results holding none.
+func holdsDraw(m interface{}) bool {
+	if result, ok := m.(*pb.DrawResult); ok {
+		return len(result.Numbers) > 0
+	}
+
+	return true
+}
@@ -0,0 +1,268 @@
+package random
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+)
+
+func testQuotasConfig() *config.Quotas {
+	return &config.Quotas{
+		Enabled:     true,
+		DefaultTier: "free",
+		Tiers: []config.QuotaTier{
+			{Name: "free", Daily: 2, Monthly: 3},
+			{Name: "pro"},
+		},
+		Clients: []config.QuotaClient{
+			{Name: "acme", APIKey: "acme-key", Tier: "pro"},
+			{Name: "office", ClientIP: "192.0.2.9", Daily: 5},
+		},
+		FlushInterval:       time.Minute,
+		MaxAnonymousClients: 2,
+	}
+}
+
+// newTestQuotaClient returns a client of a server enforcing quotas at the
+// time now points to.
+func newTestQuotaClient(t *testing.T, now *time.Time) pb.RandomServiceClient {
+	usage := newTestUsageRepository(t, "", now)
+	quotas, err := NewQuotas(testQuotasConfig(), usage)
+	assert.NoError(t, err)
+	quotas.now = func() time.Time {
+		return *now
+	}
+
+	service := newTestService()
+	service.quotas = quotas
+
+	return newTestServiceClient(t, service,
+		grpc.ChainUnaryInterceptor(quotas.Unary),
+		grpc.ChainStreamInterceptor(quotas.Stream),
+	)
+}
+
+func quotaContext(pairs ...string) context.Context {
+	return metadata.AppendToOutgoingContext(context.Background(), pairs...)
+}
+
+func TestNewQuotas(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*config.Quotas)
+	}{
+		{
+			name:   "Unknown default tier",
+			modify: func(c *config.Quotas) { c.DefaultTier = "gold" },
+		},
+		{
+			name:   "Unknown client tier",
+			modify: func(c *config.Quotas) { c.Clients[0].Tier = "gold" },
+		},
+		{
+			name:   "Duplicate tier",
+			modify: func(c *config.Quotas) { c.Tiers[1].Name = "free" },
+		},
+		{
+			name:   "Duplicate client",
+			modify: func(c *config.Quotas) { c.Clients[1].Name = "acme" },
+		},
+		{
+			name:   "Duplicate API key",
+			modify: func(c *config.Quotas) { c.Clients[1].APIKey = "acme-key" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testQuotasConfig()
+			tt.modify(config)
+			_, err := NewQuotas(config, nil)
+			assert.Error(t, err)
+		})
+	}
+
+	_, err := NewQuotas(testQuotasConfig(), nil)
+	assert.NoError(t, err)
+}
+
+func TestQuotas_Unary(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	randClient := newTestQuotaClient(t, &now)
+	ctx := quotaContext(grpcUtils.ClientIPHeader, "192.0.2.1")
+	request := &pb.GetRandNumberRequest{SeedNum: 42}
+
+	// Failed draws are not counted
+	_, err := randClient.GetRandNumber(ctx, &pb.GetRandNumberRequest{SeedNum: 1})
+	assert.Error(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = randClient.GetRandNumber(ctx, request)
+		assert.NoError(t, err)
+	}
+	_, err = randClient.GetRandNumber(ctx, request)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "daily quota of 2 draws exceeded, resets at 2026-10-19T00:00:00Z")
+	details := status.Convert(err).Details()
+	assert.Len(t, details, 1)
+	if info, ok := details[0].(*errdetails.RetryInfo); assert.True(t, ok) {
+		assert.Equal(t, 12*time.Hour, info.RetryDelay.AsDuration())
+	}
+
+	usage, err := randClient.GetUsage(ctx, &pb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "192.0.2.1", usage.Client)
+	assert.Equal(t, "free", usage.Tier)
+	assert.Len(t, usage.Quotas, 2)
+	assert.Equal(t, pb.QuotaWindow_QUOTA_WINDOW_DAILY, usage.Quotas[0].Window)
+	assert.Equal(t, int64(2), usage.Quotas[0].Used, "Expected rejected draws not to be counted")
+	assert.Equal(t, int64(2), usage.Quotas[0].Limit)
+	assert.Equal(t, pb.QuotaWindow_QUOTA_WINDOW_MONTHLY, usage.Quotas[1].Window)
+	assert.Equal(t, int64(2), usage.Quotas[1].Used)
+	assert.Equal(t, time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC).UnixNano(), usage.Quotas[1].ResetsAt)
+
+	// Other clients have their own quotas
+	_, err = randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.2"), request)
+	assert.NoError(t, err)
+
+	// The next day the monthly quota runs out first
+	now = now.Add(24 * time.Hour)
+	_, err = randClient.GetRandNumber(ctx, request)
+	assert.NoError(t, err)
+	_, err = randClient.GetRandNumber(ctx, request)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "monthly quota of 3 draws exceeded, resets at 2026-11-01T00:00:00Z")
+
+	// Methods that do not draw are not counted
+	_, err = randClient.GetPublicKey(ctx, &pb.GetPublicKeyRequest{})
+	assert.NotEqual(t, codes.ResourceExhausted, status.Code(err))
+}
+
+func TestQuotas_Clients(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	randClient := newTestQuotaClient(t, &now)
+	request := &pb.GetRandNumberRequest{SeedNum: 42}
+
+	// API keys identify clients whatever their IP, pro clients have no limits
+	for _, ip := range []string{"192.0.2.1", "192.0.2.2", "192.0.2.3"} {
+		_, err := randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, ip, grpcUtils.APIKeyHeader, "acme-key"), request)
+		assert.NoError(t, err)
+	}
+	usage, err := randClient.GetUsage(quotaContext(grpcUtils.APIKeyHeader, "acme-key"), &pb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", usage.Client)
+	assert.Equal(t, "pro", usage.Tier)
+	assert.Equal(t, int64(3), usage.Quotas[0].Used)
+	assert.Zero(t, usage.Quotas[0].Limit)
+
+	// Limits of listed IPs override their tier
+	usage, err = randClient.GetUsage(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.9"), &pb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, "office", usage.Client)
+	assert.Equal(t, "free", usage.Tier)
+	assert.Equal(t, int64(5), usage.Quotas[0].Limit)
+	assert.Equal(t, int64(3), usage.Quotas[1].Limit)
+
+	ctx := quotaContext(grpcUtils.ClientIPHeader, "192.0.2.1", grpcUtils.APIKeyHeader, "made-up")
+	_, err = randClient.GetRandNumber(ctx, request)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = randClient.GetUsage(ctx, &pb.GetUsageRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestQuotas_AnonymousClients(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	randClient := newTestQuotaClient(t, &now)
+	request := &pb.GetRandNumberRequest{SeedNum: 42}
+
+	for _, ip := range []string{"192.0.2.1", "192.0.2.2"} {
+		_, err := randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, ip), request)
+		assert.NoError(t, err)
+	}
+	_, err := randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.3"), request)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "Expected the anonymous clients counted to be bounded")
+
+	// Known clients are not anonymous
+	_, err = randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.1"), request)
+	assert.NoError(t, err)
+	_, err = randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.9"), request)
+	assert.NoError(t, err)
+	_, err = randClient.GetRandNumber(quotaContext(grpcUtils.APIKeyHeader, "acme-key"), request)
+	assert.NoError(t, err)
+
+	// Counts of the previous month no longer take room
+	now = time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+	_, err = randClient.GetRandNumber(quotaContext(grpcUtils.ClientIPHeader, "192.0.2.3"), request)
+	assert.NoError(t, err)
+}
+
+func TestQuotas_Stream(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	randClient := newTestQuotaClient(t, &now)
+	ctx := quotaContext(grpcUtils.ClientIPHeader, "192.0.2.1")
+
+	stream, err := randClient.StreamRandNumbers(ctx, &pb.StreamRandNumbersRequest{SeedNum: 42, Count: 5})
+	assert.NoError(t, err)
+	received := 0
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+		received++
+	}
+	assert.NotEqual(t, io.EOF, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err), "Expected the stream to end once the quota is used up")
+	assert.Equal(t, 2, received)
+}
+
+func TestQuotas_DrawSession(t *testing.T) {
+	now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+	randClient := newTestQuotaClient(t, &now)
+	ctx := quotaContext(grpcUtils.ClientIPHeader, "192.0.2.1")
+
+	stream, err := randClient.DrawSession(ctx)
+	assert.NoError(t, err)
+	commands := []*pb.DrawCommand{
+		{ID: 1, Command: &pb.DrawCommand_Reseed{Reseed: &pb.ReseedCommand{SeedNum: 42}}},
+		{ID: 2, Command: &pb.DrawCommand_Reseed{Reseed: &pb.ReseedCommand{SeedNum: 43}}},
+		{ID: 3, Command: &pb.DrawCommand_Skip{Skip: &pb.SkipCommand{N: 5}}},
+		{ID: 4, Command: &pb.DrawCommand_Reseed{Reseed: &pb.ReseedCommand{SeedNum: 44}}},
+	}
+	for _, command := range commands {
+		assert.NoError(t, stream.Send(command))
+		_, err = stream.Recv()
+		assert.NoError(t, err)
+	}
+
+	usage, err := randClient.GetUsage(ctx, &pb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Zero(t, usage.Quotas[0].Used, "Expected results without drawn values not to be counted")
+
+	assert.NoError(t, stream.Send(&pb.DrawCommand{ID: 5, Command: &pb.DrawCommand_NextInt{NextInt: &pb.NextIntCommand{Count: 3}}}))
+	_, err = stream.Recv()
+	assert.NoError(t, err)
+	assert.NoError(t, stream.CloseSend())
+
+	usage, err = randClient.GetUsage(ctx, &pb.GetUsageRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), usage.Quotas[0].Used)
+}
+
+func TestQuotas_Disabled(t *testing.T) {
+	randClient := newTestClient(t)
+
+	_, err := randClient.GetUsage(context.Background(), &pb.GetUsageRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
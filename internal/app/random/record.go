@@ -0,0 +1,116 @@
+package random
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+const (
+	// maxRecordFields is the largest number of fields of a record schema.
+	maxRecordFields = 64
+	// maxRecordCount is the largest number of records generated at once.
+	maxRecordCount = 1000000
+	// maxEnumValues is the largest number of values of an enum field.
+	maxEnumValues = 1000
+)
+
+var (
+	//go:embed words/first_names.txt
+	firstNamesFile string
+	//go:embed words/last_names.txt
+	lastNamesFile string
+
+	firstNames = strings.Fields(firstNamesFile)
+	lastNames  = strings.Fields(lastNamesFile)
+	// emailDomains are reserved for documentation, fixtures never reach real inboxes
+	emailDomains = []string{"example.com", "example.net", "example.org"}
+)
+
+// newRecord generates the fields of schema in order, drawing from rand.
+func newRecord(rand *rand.Rand, index int64, schema []entity.FieldSpec) entity.Record {
+	record := entity.Record{
+		Index:  index,
+		Fields: make([]entity.FieldValue, 0, len(schema)),
+	}
+	for _, field := range schema {
+		value := entity.FieldValue{Name: field.Name, Kind: field.Kind}
+		switch field.Kind {
+		case entity.PersonName:
+			value.String = pick(rand, firstNames) + " " + pick(rand, lastNames)
+		case entity.Email:
+			domain := field.Domain
+			if domain == "" {
+				domain = pick(rand, emailDomains)
+			}
+			value.String = strings.ToLower(pick(rand, firstNames)+"."+pick(rand, lastNames)) +
+				strconv.FormatUint(uint64n(rand, 100), 10) + "@" + domain
+		case entity.IntField:
+			value.Int = intInRange(rand, field.IntRange)
+		case entity.DateField:
+			// Durations saturate after 292 years, Unix seconds do not
+			days := (field.DateRange.Max.Unix() - field.DateRange.Min.Unix()) / secondsPerDay
+			offset := intInRange(rand, entity.IntRange{Min: 0, Max: days})
+			value.String = field.DateRange.Min.AddDate(0, 0, int(offset)).Format(time.DateOnly)
+		case entity.EnumField:
+			value.String = pick(rand, field.Values)
+		case entity.UUIDField:
+			value.String = newUUIDv4(readBytes(rand, 16))
+		}
+		record.Fields = append(record.Fields, value)
+	}
+
+	return record
+}
+
+// secondsPerDay is the length of the UTC days of date ranges.
+const secondsPerDay = 24 * 60 * 60
+
+func pick(rand *rand.Rand, values []string) string {
+	return values[uint64n(rand, uint64(len(values)))]
+}
+
+// validateSchema checks the fields of a record schema. Dates must be days in
+// UTC, as parsed from YYYY-MM-DD.
+func validateSchema(schema []entity.FieldSpec) error {
+	if len(schema) < 1 || len(schema) > maxRecordFields {
+		return fmt.Errorf("validate: a schema has between 1 and %d fields", maxRecordFields)
+	}
+
+	names := make(map[string]bool, len(schema))
+	for _, field := range schema {
+		if field.Name == "" {
+			return errors.New("validate: field names must not be empty")
+		}
+		if names[field.Name] {
+			return fmt.Errorf("validate: field %q is defined twice", field.Name)
+		}
+		names[field.Name] = true
+
+		switch field.Kind {
+		case entity.PersonName, entity.Email, entity.UUIDField:
+		case entity.IntField:
+			if field.IntRange.Min > field.IntRange.Max {
+				return fmt.Errorf("validate: field %q: min must be less than or equal to max", field.Name)
+			}
+		case entity.DateField:
+			if field.DateRange.Max.Before(field.DateRange.Min) {
+				return fmt.Errorf("validate: field %q: the first day must not be after the last one", field.Name)
+			}
+		case entity.EnumField:
+			if len(field.Values) < 1 || len(field.Values) > maxEnumValues {
+				return fmt.Errorf("validate: field %q: enums have between 1 and %d values", field.Name, maxEnumValues)
+			}
+		default:
+			return fmt.Errorf("validate: field %q has unknown kind %q", field.Name, field.Kind)
+		}
+	}
+
+	return nil
+}
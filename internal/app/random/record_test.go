@@ -0,0 +1,120 @@
+package random
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestNewRecord(t *testing.T) {
+	first := time.Date(2024, 2, 27, 0, 0, 0, 0, time.UTC)
+	last := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	schema := []entity.FieldSpec{
+		{Name: "name", Kind: entity.PersonName},
+		{Name: "email", Kind: entity.Email},
+		{Name: "work_email", Kind: entity.Email, Domain: "corp.test"},
+		{Name: "age", Kind: entity.IntField, IntRange: entity.IntRange{Min: 18, Max: 21}},
+		{Name: "joined", Kind: entity.DateField, DateRange: entity.DateRange{Min: first, Max: last}},
+		{Name: "plan", Kind: entity.EnumField, Values: []string{"free", "pro"}},
+		{Name: "id", Kind: entity.UUIDField},
+	}
+
+	r := rand.New(rand.NewSource(42))
+	days := map[string]bool{}
+	for i := int64(0); i < 500; i++ {
+		record := newRecord(r, i, schema)
+		assert.Equal(t, i, record.Index)
+		assert.Len(t, record.Fields, len(schema))
+		for j, field := range record.Fields {
+			assert.Equal(t, schema[j].Name, field.Name)
+			assert.Equal(t, schema[j].Kind, field.Kind)
+		}
+
+		assert.Regexp(t, `^[A-Z][a-z]+ [A-Z][a-z]+$`, record.Fields[0].String)
+		assert.Regexp(t, `^[a-z]+\.[a-z]+[0-9]{1,2}@example\.(com|net|org)$`, record.Fields[1].String)
+		assert.Regexp(t, `@corp\.test$`, record.Fields[2].String)
+		assert.GreaterOrEqual(t, record.Fields[3].Int, int64(18))
+		assert.LessOrEqual(t, record.Fields[3].Int, int64(21))
+
+		day, err := time.Parse(time.DateOnly, record.Fields[4].String)
+		assert.NoError(t, err)
+		assert.False(t, day.Before(first) || day.After(last), "Expected %s within the date range", day)
+		days[record.Fields[4].String] = true
+
+		assert.Contains(t, []string{"free", "pro"}, record.Fields[5].String)
+		assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, record.Fields[6].String)
+	}
+	assert.Len(t, days, 5, "Expected both ends of the date range, across the leap day")
+}
+
+func TestNewRecord_WideDateRange(t *testing.T) {
+	first := time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(9999, 12, 31, 0, 0, 0, 0, time.UTC)
+	schema := []entity.FieldSpec{
+		{Name: "day", Kind: entity.DateField, DateRange: entity.DateRange{Min: first, Max: last}},
+	}
+
+	r := rand.New(rand.NewSource(42))
+	var latest time.Time
+	for i := int64(0); i < 100; i++ {
+		day, err := time.Parse(time.DateOnly, newRecord(r, i, schema).Fields[0].String)
+		assert.NoError(t, err)
+		assert.False(t, day.Before(first) || day.After(last), "Expected %s within the date range", day)
+		if day.After(latest) {
+			latest = day
+		}
+	}
+	assert.Greater(t, latest.Year(), 5000, "Expected dates across the whole range")
+}
+
+func TestWordLists(t *testing.T) {
+	assert.NotEmpty(t, firstNames)
+	assert.NotEmpty(t, lastNames)
+}
+
+func TestValidateSchema(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tooMany := make([]entity.FieldSpec, maxRecordFields+1)
+	for i := range tooMany {
+		tooMany[i] = entity.FieldSpec{Name: string(rune('a' + i%26)), Kind: entity.UUIDField}
+	}
+
+	tests := []struct {
+		name        string
+		schema      []entity.FieldSpec
+		expectError bool
+	}{
+		{
+			name: "Valid",
+			schema: []entity.FieldSpec{
+				{Name: "id", Kind: entity.UUIDField},
+				{Name: "age", Kind: entity.IntField, IntRange: entity.IntRange{Min: 1, Max: 1}},
+				{Name: "day", Kind: entity.DateField, DateRange: entity.DateRange{Min: day, Max: day}},
+				{Name: "plan", Kind: entity.EnumField, Values: []string{"free"}},
+			},
+		},
+		{name: "Empty", expectError: true},
+		{name: "Too Many Fields", schema: tooMany, expectError: true},
+		{name: "Empty Name", schema: []entity.FieldSpec{{Kind: entity.UUIDField}}, expectError: true},
+		{name: "Duplicate Name", schema: []entity.FieldSpec{{Name: "id", Kind: entity.UUIDField}, {Name: "id", Kind: entity.Email}}, expectError: true},
+		{name: "Inverted Int Range", schema: []entity.FieldSpec{{Name: "n", Kind: entity.IntField, IntRange: entity.IntRange{Min: 2, Max: 1}}}, expectError: true},
+		{name: "Inverted Date Range", schema: []entity.FieldSpec{{Name: "d", Kind: entity.DateField, DateRange: entity.DateRange{Min: day, Max: day.AddDate(0, 0, -1)}}}, expectError: true},
+		{name: "Empty Enum", schema: []entity.FieldSpec{{Name: "e", Kind: entity.EnumField}}, expectError: true},
+		{name: "Unknown Kind", schema: []entity.FieldSpec{{Name: "x", Kind: "phone"}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSchema(tt.schema)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
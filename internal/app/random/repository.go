@@ -2,17 +2,38 @@ package random
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
 	"math/rand"
+	"sort"
 
 	"github.com/minhthong582000/soa-404/internal/entity"
 	"github.com/minhthong582000/soa-404/pkg/tracing"
 )
 
 type RandomRepo struct {
+	algorithm algorithm
 }
 
+// NewRepository returns the repository of the legacy math/rand algorithm.
 func NewRepository() *RandomRepo {
-	return &RandomRepo{}
+	return newRepository(algorithms[Legacy])
+}
+
+func newRepository(algorithm algorithm) *RandomRepo {
+	return &RandomRepo{
+		algorithm: algorithm,
+	}
+}
+
+func (r *RandomRepo) Algorithm() entity.Algorithm {
+	return r.algorithm.Algorithm
+}
+
+// newRand returns the generator used to serve a request for seed.
+func (r *RandomRepo) newRand(seed int64) *rand.Rand {
+	return rand.New(r.algorithm.newSource(seed))
 }
 
 func (r *RandomRepo) Get(ctx context.Context, seed int64) (entity.Random, error) {
@@ -20,10 +41,335 @@ func (r *RandomRepo) Get(ctx context.Context, seed int64) (entity.Random, error)
 	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetRandNumber")
 	defer tracer.EndSpan(ctx)
 
-	rand := rand.New(rand.NewSource(seed))
+	rand := r.newRand(seed)
 	randNum := rand.Int63()
 
 	return entity.Random{
 		Number: randNum,
 	}, nil
 }
+
+func (r *RandomRepo) Stream(ctx context.Context, seed int64, count int64, send func(entity.Random) error) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.StreamRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+	for i := int64(0); count == 0 || i < count; i++ {
+		// Stop generating as soon as the caller goes away
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := send(entity.Random{Number: rand.Int63()}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RandomRepo) GetBatch(ctx context.Context, seeds []int64) ([]entity.RandomResult, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	results := make([]entity.RandomResult, 0, len(seeds))
+	for _, seed := range seeds {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		rand := r.newRand(seed)
+		results = append(results, entity.RandomResult{
+			Seed: seed,
+			Random: entity.Random{
+				Number: rand.Int63(),
+			},
+		})
+	}
+
+	return results, nil
+}
+
+func (r *RandomRepo) GetInIntRange(ctx context.Context, seed int64, bounds entity.IntRange) (entity.Random, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetRandNumberInIntRange")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+
+	return entity.Random{
+		Number: intInRange(rand, bounds),
+	}, nil
+}
+
+func (r *RandomRepo) GetInFloatRange(ctx context.Context, seed int64, bounds entity.FloatRange) (entity.Random, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetRandNumberInFloatRange")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+
+	return entity.Random{
+		Float: floatInRange(rand, bounds),
+	}, nil
+}
+
+// intInRange returns a uniformly distributed integer in [bounds.Min, bounds.Max].
+func intInRange(rand *rand.Rand, bounds entity.IntRange) int64 {
+	// The width is computed in uint64 so that the full int64 range does not overflow
+	width := uint64(bounds.Max) - uint64(bounds.Min)
+	if width == math.MaxUint64 {
+		return int64(rand.Uint64())
+	}
+
+	return bounds.Min + int64(uint64n(rand, width+1))
+}
+
+// uint64n returns a uniformly distributed integer in [0, n).
+// Values from the top of the uint64 range that would make some results
+// more likely than others are rejected, so unlike `Uint64() % n` it is unbiased.
+func uint64n(rand *rand.Rand, n uint64) uint64 {
+	// Largest value v such that [0, v] holds a whole number of [0, n) ranges
+	limit := math.MaxUint64 - (math.MaxUint64%n+1)%n
+	for {
+		v := rand.Uint64()
+		if v <= limit {
+			return v % n
+		}
+	}
+}
+
+// floatInRange returns a uniformly distributed float in [bounds.Min, bounds.Max).
+func floatInRange(rand *rand.Rand, bounds entity.FloatRange) float64 {
+	if bounds.Min == bounds.Max {
+		return bounds.Min
+	}
+
+	f := rand.Float64()
+	v := bounds.Min + f*(bounds.Max-bounds.Min)
+	if math.IsInf(bounds.Max-bounds.Min, 0) {
+		// The width overflows, interpolate between the bounds instead
+		v = bounds.Min*(1-f) + bounds.Max*f
+	}
+
+	// Rounding can land exactly on the excluded upper bound
+	if v >= bounds.Max {
+		v = math.Nextafter(bounds.Max, bounds.Min)
+	}
+
+	return v
+}
+
+func (r *RandomRepo) Sample(ctx context.Context, seed int64, dist entity.Distribution, count int64) ([]float64, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.SampleDistribution")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+	sample, err := newSampler(rand, dist)
+	if err != nil {
+		return nil, err
+	}
+
+	samples := make([]float64, 0, count)
+	for i := int64(0); i < count; i++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		samples = append(samples, sample())
+	}
+
+	return samples, nil
+}
+
+func (r *RandomRepo) Read(ctx context.Context, seed int64, n int) ([]byte, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Read")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+
+	return readBytes(rand, n), nil
+}
+
+// readBytes returns n bytes taken 8 at a time from rand.Uint64.
+func readBytes(rand *rand.Rand, n int) []byte {
+	b := make([]byte, n)
+	for i := 0; i < n; i += 8 {
+		var chunk [8]byte
+		binary.LittleEndian.PutUint64(chunk[:], rand.Uint64())
+		copy(b[i:], chunk[:])
+	}
+
+	return b
+}
+
+// shuffleAlgorithm identifies the implementation of Shuffle, it must change
+// whenever the output for a given seed changes.
+const shuffleAlgorithm = "fisher-yates/v1"
+
+func (r *RandomRepo) Shuffle(ctx context.Context, seed int64, n int64, k int64) (entity.Permutation, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Shuffle")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+
+	// Forward Fisher-Yates stopped after k swaps, positions [0, k) then hold
+	// a uniform sample drawn without replacement
+	indices := make([]int64, n)
+	for i := range indices {
+		indices[i] = int64(i)
+	}
+	for i := int64(0); i < k; i++ {
+		j := i + int64(uint64n(rand, uint64(n-i)))
+		indices[i], indices[j] = indices[j], indices[i]
+	}
+
+	return entity.Permutation{
+		Indices:          indices[:k],
+		ShuffleAlgorithm: shuffleAlgorithm,
+	}, nil
+}
+
+func (r *RandomRepo) Roll(ctx context.Context, seed int64, expr entity.DiceExpression) (entity.Roll, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Roll")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+	roll := entity.Roll{
+		Total:    expr.Modifier,
+		Modifier: expr.Modifier,
+	}
+	for term, dice := range expr.Dice {
+		faces := entity.IntRange{Min: 1, Max: dice.Sides}
+		pool := make([]entity.DieRoll, 0, dice.Count)
+		for i := 0; i < dice.Count; i++ {
+			pool = append(pool, entity.DieRoll{
+				Term:  term,
+				Sides: dice.Sides,
+				Value: intInRange(rand, faces),
+			})
+		}
+
+		// Every die showing its highest face adds one more die to the pool
+		for i := 0; dice.Explode && i < len(pool) && len(pool) < dice.Count+maxExplosions; i++ {
+			if pool[i].Value == dice.Sides {
+				pool = append(pool, entity.DieRoll{
+					Term:     term,
+					Sides:    dice.Sides,
+					Value:    intInRange(rand, faces),
+					Exploded: true,
+				})
+			}
+		}
+
+		keepDice(pool, dice.Keep, dice.KeepLowest)
+		for _, die := range pool {
+			if !die.Kept {
+				continue
+			}
+			if dice.Negative {
+				roll.Total -= die.Value
+			} else {
+				roll.Total += die.Value
+			}
+		}
+		roll.Dice = append(roll.Dice, pool...)
+	}
+
+	return roll, nil
+}
+
+// Strings returns count strings matching pattern, built from the sequence
+// generated by seed.
+func (r *RandomRepo) Strings(ctx context.Context, seed int64, pattern entity.Pattern, count int) ([]string, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Strings")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+	values := make([]string, 0, count)
+	buf := make([]byte, 0, 64)
+	for i := 0; i < count; i++ {
+		buf = buf[:0]
+		for _, part := range pattern.Parts {
+			n := part.Min + int(uint64n(rand, uint64(part.Max-part.Min+1)))
+			for j := 0; j < n; j++ {
+				buf = append(buf, part.Charset[uint64n(rand, uint64(len(part.Charset)))])
+			}
+		}
+		values = append(values, string(buf))
+	}
+
+	return values, nil
+}
+
+// Records calls send with count records of schema generated by seed.
+func (r *RandomRepo) Records(ctx context.Context, seed int64, schema []entity.FieldSpec, count int64, send func(entity.Record) error) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Records")
+	defer tracer.EndSpan(ctx)
+
+	rand := r.newRand(seed)
+	for i := int64(0); i < count; i++ {
+		// Stop generating as soon as the caller goes away
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := send(newRecord(rand, i, schema)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// keepDice marks the keep highest or lowest dice of pool as kept, or every
+// die when keep is 0. Ties are broken by rolling order.
+func keepDice(pool []entity.DieRoll, keep int, lowest bool) {
+	if keep == 0 || keep >= len(pool) {
+		for i := range pool {
+			pool[i].Kept = true
+		}
+		return
+	}
+
+	order := make([]int, len(pool))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		if lowest {
+			return pool[order[a]].Value < pool[order[b]].Value
+		}
+		return pool[order[a]].Value > pool[order[b]].Value
+	})
+	for _, i := range order[:keep] {
+		pool[i].Kept = true
+	}
+}
+
+func (r *RandomRepo) GetAt(ctx context.Context, seed int64, index int64, count int) (entity.Sequence, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetRandNumberAt")
+	defer tracer.EndSpan(ctx)
+
+	if r.algorithm.at == nil {
+		return entity.Sequence{}, fmt.Errorf("validate: algorithm %q cannot jump ahead", r.algorithm.Name)
+	}
+
+	numbers, err := r.algorithm.at(seed, uint64(index), count)
+	if err != nil {
+		return entity.Sequence{}, err
+	}
+
+	return entity.Sequence{
+		Numbers: numbers,
+		Index:   index,
+	}, nil
+}
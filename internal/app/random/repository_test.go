@@ -1 +1,284 @@
 package random
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+func TestRandomRepo_Stream(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	first, err := repo.Get(ctx, 42)
+	assert.NoError(t, err)
+
+	var got []int64
+	err = repo.Stream(ctx, 42, 5, func(randNum entity.Random) error {
+		got = append(got, randNum.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Len(t, got, 5, "Expected exactly count values")
+	assert.Equal(t, first.Number, got[0], "Expected the first streamed value to match Get")
+
+	var again []int64
+	err = repo.Stream(ctx, 42, 5, func(randNum entity.Random) error {
+		again = append(again, randNum.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, got, again, "Expected the same seed to produce the same sequence")
+}
+
+func TestRandomRepo_StreamUntilCancelled(t *testing.T) {
+	repo := NewRepository()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	sent := 0
+	err := repo.Stream(ctx, 42, 0, func(randNum entity.Random) error {
+		sent++
+		if sent == 100 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 100, sent, "Expected the stream to stop right after cancellation")
+}
+
+func TestRandomRepo_StreamSendError(t *testing.T) {
+	repo := NewRepository()
+	sendErr := errors.New("send failed")
+
+	err := repo.Stream(context.Background(), 42, 10, func(randNum entity.Random) error {
+		return sendErr
+	})
+	assert.ErrorIs(t, err, sendErr)
+}
+
+func TestRandomRepo_GetInIntRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds entity.IntRange
+	}{
+		{name: "Small Range", bounds: entity.IntRange{Min: 1, Max: 6}},
+		{name: "Negative Range", bounds: entity.IntRange{Min: -10, Max: -5}},
+		{name: "Single Value", bounds: entity.IntRange{Min: 7, Max: 7}},
+		{name: "Full Range", bounds: entity.IntRange{Min: math.MinInt64, Max: math.MaxInt64}},
+		{name: "Wider Than Int64", bounds: entity.IntRange{Min: -10, Max: math.MaxInt64}},
+	}
+
+	repo := NewRepository()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(3); seed < 200; seed++ {
+				randNum, err := repo.GetInIntRange(context.Background(), seed, tt.bounds)
+				assert.NoError(t, err)
+				assert.GreaterOrEqual(t, randNum.Number, tt.bounds.Min)
+				assert.LessOrEqual(t, randNum.Number, tt.bounds.Max)
+			}
+		})
+	}
+}
+
+func TestUint64n_Uniform(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	counts := make([]int, 6)
+	for i := 0; i < 60000; i++ {
+		counts[uint64n(r, 6)]++
+	}
+
+	for i, count := range counts {
+		assert.InDelta(t, 10000, count, 500, "Expected value %d to be drawn about as often as the others", i)
+	}
+}
+
+func TestRandomRepo_GetInFloatRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		bounds entity.FloatRange
+	}{
+		{name: "Unit Range", bounds: entity.FloatRange{Min: 0, Max: 1}},
+		{name: "Negative Range", bounds: entity.FloatRange{Min: -2.5, Max: -1.5}},
+		{name: "Overflowing Width", bounds: entity.FloatRange{Min: -math.MaxFloat64, Max: math.MaxFloat64}},
+	}
+
+	repo := NewRepository()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for seed := int64(3); seed < 200; seed++ {
+				randNum, err := repo.GetInFloatRange(context.Background(), seed, tt.bounds)
+				assert.NoError(t, err)
+				assert.GreaterOrEqual(t, randNum.Float, tt.bounds.Min)
+				assert.Less(t, randNum.Float, tt.bounds.Max)
+			}
+		})
+	}
+
+	randNum, err := repo.GetInFloatRange(context.Background(), 42, entity.FloatRange{Min: 3.5, Max: 3.5})
+	assert.NoError(t, err)
+	assert.Equal(t, 3.5, randNum.Float, "Expected the bound when min equals max")
+}
+
+func TestSecureRandomRepo_Get(t *testing.T) {
+	repo := NewSecureRepository()
+	ctx := context.Background()
+
+	seen := make(map[int64]bool)
+	for i := 0; i < 100; i++ {
+		randNum, err := repo.Get(ctx, 42)
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, randNum.Number, int64(0))
+		seen[randNum.Number] = true
+	}
+	assert.Len(t, seen, 100, "Expected the seed to be ignored by the secure repository")
+}
+
+func TestRandomRepo_Shuffle(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	permutation, err := repo.Shuffle(ctx, 42, 10, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, shuffleAlgorithm, permutation.ShuffleAlgorithm)
+	// Pinned output, a change here means shuffleAlgorithm needs a new version
+	assert.Equal(t, []int64{3, 7, 2, 4, 5, 6, 9, 0, 8, 1}, permutation.Indices)
+	assert.ElementsMatch(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, permutation.Indices, "Expected a permutation of every position")
+
+	sample, err := repo.Shuffle(ctx, 42, 10, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, permutation.Indices[:4], sample.Indices, "Expected a sample to be a prefix of the permutation")
+}
+
+func TestRandomRepo_ShuffleUniform(t *testing.T) {
+	repo := NewRepository()
+
+	counts := make(map[[3]int64]int)
+	for seed := int64(0); seed < 6000; seed++ {
+		permutation, err := repo.Shuffle(context.Background(), seed, 3, 3)
+		assert.NoError(t, err)
+		counts[[3]int64(permutation.Indices)]++
+	}
+
+	assert.Len(t, counts, 6, "Expected every permutation of 3 items")
+	for permutation, count := range counts {
+		assert.InDelta(t, 1000, count, 150, "Expected permutation %v about as often as the others", permutation)
+	}
+}
+
+func TestRandomRepo_Roll(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	for seed := int64(3); seed < 200; seed++ {
+		roll, err := repo.Roll(ctx, seed, entity.DiceExpression{
+			Dice:     []entity.Dice{{Count: 4, Sides: 6, Keep: 3}, {Count: 2, Sides: 4, Negative: true}},
+			Modifier: 2,
+		})
+		assert.NoError(t, err)
+		assert.Len(t, roll.Dice, 6)
+
+		total, kept := roll.Modifier, 0
+		var dropped, lowestKept int64 = 0, 6
+		for _, die := range roll.Dice {
+			assert.GreaterOrEqual(t, die.Value, int64(1))
+			assert.LessOrEqual(t, die.Value, die.Sides)
+			if die.Term == 1 {
+				total -= die.Value
+				continue
+			}
+			if die.Kept {
+				kept++
+				total += die.Value
+				lowestKept = min(lowestKept, die.Value)
+			} else {
+				dropped = die.Value
+			}
+		}
+		assert.Equal(t, 3, kept)
+		assert.LessOrEqual(t, dropped, lowestKept, "Expected the lowest die to be dropped")
+		assert.Equal(t, total, roll.Total)
+	}
+}
+
+func TestRandomRepo_RollExplode(t *testing.T) {
+	repo := NewRepository()
+
+	roll, err := repo.Roll(context.Background(), 42, entity.DiceExpression{
+		Dice: []entity.Dice{{Count: 50, Sides: 2, Explode: true}},
+	})
+	assert.NoError(t, err)
+	assert.Greater(t, len(roll.Dice), 50, "Expected dice showing their highest face to explode")
+	assert.LessOrEqual(t, len(roll.Dice), 50+maxExplosions)
+
+	exploding := 0
+	for i, die := range roll.Dice {
+		if die.Value == 2 {
+			exploding++
+		}
+		assert.Equal(t, i >= 50, die.Exploded)
+	}
+	assert.Equal(t, min(exploding, maxExplosions), len(roll.Dice)-50, "Expected one extra die per highest face")
+}
+
+func TestRandomRepo_Strings(t *testing.T) {
+	repo := NewRepository()
+	ctx := context.Background()
+
+	pattern, err := parsePattern(`[A-Z]{3}-\d{2,4}`)
+	assert.NoError(t, err)
+
+	values, err := repo.Strings(ctx, 42, pattern, 200)
+	assert.NoError(t, err)
+	assert.Len(t, values, 200)
+
+	match := regexp.MustCompile(`^[A-Z]{3}-[0-9]{2,4}$`)
+	lengths := map[int]bool{}
+	for _, value := range values {
+		assert.Regexp(t, match, value)
+		lengths[len(value)] = true
+	}
+	assert.Len(t, lengths, 3, "Expected every length between the bounds of the repetition")
+
+	again, err := repo.Strings(ctx, 42, pattern, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, values, again, "Expected the same seed to produce the same strings")
+}
+
+func TestRandomRepo_Records(t *testing.T) {
+	repo := NewRepository()
+	schema := []entity.FieldSpec{
+		{Name: "id", Kind: entity.UUIDField},
+		{Name: "name", Kind: entity.PersonName},
+	}
+
+	collect := func(ctx context.Context, count int64) ([]entity.Record, error) {
+		var records []entity.Record
+		err := repo.Records(ctx, 42, schema, count, func(record entity.Record) error {
+			records = append(records, record)
+			return nil
+		})
+		return records, err
+	}
+
+	records, err := collect(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Len(t, records, 100)
+
+	prefix, err := collect(context.Background(), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, records[:10], prefix, "Expected the first records not to depend on the count")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = collect(ctx, 100)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,50 @@
+package random
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math/rand"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+)
+
+// SecureRandomRepo draws every value from crypto/rand. Seeds are ignored,
+// so its output cannot be predicted nor reproduced.
+type SecureRandomRepo struct {
+	RandomRepo
+}
+
+func NewSecureRepository() *SecureRandomRepo {
+	return &SecureRandomRepo{
+		RandomRepo: RandomRepo{
+			algorithm: algorithm{
+				Algorithm: entity.Algorithm{Name: "crypto/rand"},
+				newSource: func(int64) rand.Source {
+					return cryptoSource{}
+				},
+			},
+		},
+	}
+}
+
+// cryptoSource is a rand.Source64 backed by crypto/rand. The methods of
+// rand.Rand built on top of it (Int63n, Float64, ...) stay unbiased,
+// they only consume the source's bits.
+type cryptoSource struct{}
+
+func (cryptoSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		// rand.Source cannot return errors, the recovery interceptor turns this into an Internal error
+		panic(fmt.Sprintf("crypto/rand: %v", err))
+	}
+
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+func (s cryptoSource) Int63() int64 {
+	return int64(s.Uint64() & (1<<63 - 1))
+}
+
+func (cryptoSource) Seed(int64) {}
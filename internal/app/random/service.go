@@ -3,35 +3,826 @@ package random
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/fair"
+	"github.com/minhthong582000/soa-404/pkg/signing"
 	"github.com/minhthong582000/soa-404/pkg/tracing"
 )
 
+const (
+	// maxShuffleSize is the largest population Shuffle accepts.
+	maxShuffleSize = 100000
+	// maxSequenceCount is the largest number of values GetAt returns at once.
+	maxSequenceCount = 10000
+	// maxChoiceItems is the largest number of items Choose picks from.
+	maxChoiceItems = 10000
+	// maxStringCount is the largest number of strings GetStrings returns at once.
+	maxStringCount = 1000
+	// secretSize is the number of bytes of the secrets behind commitments.
+	secretSize = 32
+	// maxClientSeedLength is the longest client seed Draw accepts.
+	maxClientSeedLength = 256
+)
+
 type RandomService struct {
-	repo entity.IRandomRepository
+	registry    *Registry
+	secureRepo  entity.IRandomRepository
+	sessions    entity.IGeneratorRepository
+	commitments entity.ICommitmentRepository
+	jobs        entity.IJobRepository
+	draws       entity.IDrawRepository
+	quotas      *Quotas
+	signer      *signing.Signer
+	config      *config.Random
 }
 
-func NewService(repo entity.IRandomRepository) *RandomService {
+func NewService(registry *Registry, secureRepo entity.IRandomRepository, sessions entity.IGeneratorRepository, commitments entity.ICommitmentRepository, jobs entity.IJobRepository, draws entity.IDrawRepository, quotas *Quotas, signer *signing.Signer, config *config.Random) *RandomService {
 	return &RandomService{
-		repo: repo,
+		registry:    registry,
+		secureRepo:  secureRepo,
+		sessions:    sessions,
+		commitments: commitments,
+		jobs:        jobs,
+		draws:       draws,
+		quotas:      quotas,
+		signer:      signer,
+		config:      config,
+	}
+}
+
+func (s *RandomService) Algorithm(mode entity.Mode, name string) (entity.Algorithm, error) {
+	repo, err := s.lookup(mode, name)
+	if err != nil {
+		return entity.Algorithm{}, err
 	}
+
+	return repo.Algorithm(), nil
 }
 
-func (s *RandomService) Get(ctx context.Context, seed int64) (*entity.Random, error) {
+func (s *RandomService) Get(ctx context.Context, seed int64, mode entity.Mode, algorithm string) (*entity.Random, error) {
 	tracer := tracing.GetTracer()
 	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandNumber")
 	defer tracer.EndSpan(ctx)
 
-	// Validate seed
-	if seed < 2 {
-		return nil, errors.New("validate: seed must be greater than 2")
+	repo, err := s.repository(seed, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	randNum, err := repo.Get(ctx, seed)
+	if err != nil {
+		return nil, err
+	}
+	randNum.Mode = mode
+	if err := s.sign(&randNum, seed, repo.Algorithm(), "", strconv.FormatInt(randNum.Number, 10)); err != nil {
+		return nil, err
+	}
+
+	return &randNum, nil
+}
+
+// Choose returns count indices of weights, each picked with a probability
+// proportional to its weight.
+func (s *RandomService) Choose(ctx context.Context, seed int64, algorithm string, weights []float64, count int) ([]int64, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.WeightedChoice")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWeights(weights); err != nil {
+		return nil, err
+	}
+	if count < 1 || count > maxSequenceCount {
+		return nil, fmt.Errorf("validate: count must be between 1 and %d", maxSequenceCount)
+	}
+
+	uniforms, err := repo.Sample(ctx, seed, entity.Distribution{Kind: entity.Uniform, Min: 0, Max: 1}, int64(count))
+	if err != nil {
+		return nil, err
+	}
+
+	table := newAliasTable(weights)
+	indices := make([]int64, 0, count)
+	for _, u := range uniforms {
+		indices = append(indices, table.pick(u))
+	}
+
+	return indices, nil
+}
+
+func (s *RandomService) Roll(ctx context.Context, seed int64, algorithm string, notation string) (*entity.Roll, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.Roll")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := parseDice(notation)
+	if err != nil {
+		return nil, err
+	}
+
+	roll, err := repo.Roll(ctx, seed, expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &roll, nil
+}
+
+func (s *RandomService) Stream(ctx context.Context, seed int64, algorithm string, count int64, send func(entity.Random) error) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.StreamRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return err
+	}
+	if count < 0 {
+		return errors.New("validate: count must not be negative")
+	}
+
+	return repo.Stream(ctx, seed, count, send)
+}
+
+func (s *RandomService) GetBatch(ctx context.Context, seeds []int64, algorithm string) ([]entity.RandomResult, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandNumbers")
+	defer tracer.EndSpan(ctx)
+
+	if len(seeds) > s.config.MaxBatchSize {
+		return nil, fmt.Errorf("validate: batch size %d exceeds the maximum of %d", len(seeds), s.config.MaxBatchSize)
+	}
+	repo, err := s.lookup(entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Invalid seeds fail on their own, the rest of the batch is still generated
+	results := make([]entity.RandomResult, len(seeds))
+	validSeeds := make([]int64, 0, len(seeds))
+	for i, seed := range seeds {
+		results[i].Seed = seed
+		if err := validateSeed(seed); err != nil {
+			results[i].Err = err
+			continue
+		}
+		validSeeds = append(validSeeds, seed)
+	}
+
+	generated, err := repo.GetBatch(ctx, validSeeds)
+	if err != nil {
+		return nil, err
+	}
+	if len(generated) != len(validSeeds) {
+		return nil, fmt.Errorf("repository returned %d results for %d seeds", len(generated), len(validSeeds))
+	}
+
+	next := 0
+	for i := range results {
+		if results[i].Err != nil {
+			continue
+		}
+		results[i] = generated[next]
+		next++
+	}
+
+	return results, nil
+}
+
+func (s *RandomService) GetInIntRange(ctx context.Context, seed int64, algorithm string, bounds entity.IntRange) (*entity.Random, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandNumberInIntRange")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if bounds.Min > bounds.Max {
+		return nil, errors.New("validate: min must be less than or equal to max")
+	}
+
+	randNum, err := repo.GetInIntRange(ctx, seed, bounds)
+	if err != nil {
+		return nil, err
+	}
+	randNum.Mode = entity.Seeded
+	if err := s.sign(&randNum, seed, repo.Algorithm(), signing.IntRange(bounds.Min, bounds.Max), strconv.FormatInt(randNum.Number, 10)); err != nil {
+		return nil, err
+	}
+
+	return &randNum, nil
+}
+
+func (s *RandomService) GetInFloatRange(ctx context.Context, seed int64, algorithm string, bounds entity.FloatRange) (*entity.Random, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandNumberInFloatRange")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if !isFinite(bounds.Min) || !isFinite(bounds.Max) {
+		return nil, errors.New("validate: min and max must be finite")
+	}
+	if bounds.Min > bounds.Max {
+		return nil, errors.New("validate: min must be less than or equal to max")
 	}
 
-	randNum, err := s.repo.Get(ctx, seed)
+	randNum, err := repo.GetInFloatRange(ctx, seed, bounds)
 	if err != nil {
 		return nil, err
 	}
+	randNum.Mode = entity.Seeded
+	if err := s.sign(&randNum, seed, repo.Algorithm(), signing.FloatRange(bounds.Min, bounds.Max), signing.Float(randNum.Float)); err != nil {
+		return nil, err
+	}
 
 	return &randNum, nil
 }
+
+func (s *RandomService) Sample(ctx context.Context, seed int64, algorithm string, dist entity.Distribution, count int64) ([]float64, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.SampleDistribution")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if count < 1 {
+		return nil, errors.New("validate: count must be at least 1")
+	}
+	if err := validateDistribution(dist); err != nil {
+		return nil, err
+	}
+
+	return repo.Sample(ctx, seed, dist, count)
+}
+
+func (s *RandomService) GetBytes(ctx context.Context, seed int64, mode entity.Mode, algorithm string, n int) ([]byte, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandBytes")
+	defer tracer.EndSpan(ctx)
+
+	if n < 1 {
+		return nil, errors.New("validate: length must be at least 1")
+	}
+
+	return s.read(ctx, seed, mode, algorithm, n)
+}
+
+func (s *RandomService) GetUUIDs(ctx context.Context, seed int64, mode entity.Mode, algorithm string, version int, count int) ([]string, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetUUIDs")
+	defer tracer.EndSpan(ctx)
+
+	if version != 4 && version != 7 {
+		return nil, fmt.Errorf("validate: unsupported uuid version %d", version)
+	}
+	if count < 1 {
+		return nil, errors.New("validate: count must be at least 1")
+	}
+
+	b, err := s.read(ctx, seed, mode, algorithm, 16*count)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	uuids := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		chunk := b[16*i : 16*(i+1)]
+		if version == 7 {
+			uuids = append(uuids, newUUIDv7(chunk, now))
+		} else {
+			uuids = append(uuids, newUUIDv4(chunk))
+		}
+	}
+
+	return uuids, nil
+}
+
+func (s *RandomService) GetToken(ctx context.Context, seed int64, mode entity.Mode, algorithm string, length int) (string, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetToken")
+	defer tracer.EndSpan(ctx)
+
+	if length < 1 {
+		return "", errors.New("validate: length must be at least 1")
+	}
+
+	b, err := s.read(ctx, seed, mode, algorithm, length)
+	if err != nil {
+		return "", err
+	}
+
+	return newToken(b), nil
+}
+
+// GenerateRecords calls send with count reproducible records of schema.
+func (s *RandomService) GenerateRecords(ctx context.Context, seed int64, algorithm string, schema []entity.FieldSpec, count int64, send func(entity.Record) error) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GenerateRecords")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return err
+	}
+	if count < 1 || count > maxRecordCount {
+		return fmt.Errorf("validate: count must be between 1 and %d", maxRecordCount)
+	}
+	if err := validateSchema(schema); err != nil {
+		return err
+	}
+
+	return repo.Records(ctx, seed, schema, count, send)
+}
+
+// GetStrings returns count strings matching spec, see parsePattern for the
+// pattern grammar and parseCharset for charsets.
+func (s *RandomService) GetStrings(ctx context.Context, seed int64, mode entity.Mode, algorithm string, spec entity.StringSpec, count int) ([]string, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetStrings")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if count < 1 || count > maxStringCount {
+		return nil, fmt.Errorf("validate: count must be between 1 and %d", maxStringCount)
+	}
+
+	var pattern entity.Pattern
+	switch {
+	case spec.Pattern != "" && spec.Charset != "":
+		return nil, errors.New("validate: set either a pattern or a charset, not both")
+	case spec.Pattern != "":
+		pattern, err = parsePattern(spec.Pattern)
+	case spec.Charset != "":
+		pattern, err = parseCharset(spec.Charset, spec.Length)
+	default:
+		return nil, errors.New("validate: a pattern or a charset is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Strings(ctx, seed, pattern, count)
+}
+
+func (s *RandomService) Shuffle(ctx context.Context, seed int64, algorithm string, n int64, k int64) (*entity.Permutation, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.Shuffle")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if n < 1 || n > maxShuffleSize {
+		return nil, fmt.Errorf("validate: population size must be between 1 and %d", maxShuffleSize)
+	}
+	if k < 0 || k > n {
+		return nil, errors.New("validate: sample size must be between 0 and the population size")
+	}
+
+	// A sample size of 0 asks for the whole permutation
+	if k == 0 {
+		k = n
+	}
+
+	permutation, err := repo.Shuffle(ctx, seed, n, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return &permutation, nil
+}
+
+func (s *RandomService) GetAt(ctx context.Context, seed int64, algorithm string, index int64, count int) (*entity.Sequence, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetRandNumberAt")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := s.repository(seed, entity.Seeded, algorithm)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, errors.New("validate: index must not be negative")
+	}
+	if count < 1 || count > maxSequenceCount {
+		return nil, fmt.Errorf("validate: count must be between 1 and %d", maxSequenceCount)
+	}
+
+	sequence, err := repo.GetAt(ctx, seed, index, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sequence, nil
+}
+
+func (s *RandomService) CreateGenerator(ctx context.Context, seed int64, algorithm string) (*entity.Generator, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.CreateGenerator")
+	defer tracer.EndSpan(ctx)
+
+	if err := validateSeed(seed); err != nil {
+		return nil, err
+	}
+
+	generator, err := s.sessions.Create(ctx, seed, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &generator, nil
+}
+
+func (s *RandomService) Next(ctx context.Context, id string, count int) (*entity.Sequence, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.Next")
+	defer tracer.EndSpan(ctx)
+
+	if count < 1 || count > maxSequenceCount {
+		return nil, fmt.Errorf("validate: count must be between 1 and %d", maxSequenceCount)
+	}
+
+	sequence, err := s.sessions.Next(ctx, id, count)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sequence, nil
+}
+
+func (s *RandomService) CloseGenerator(ctx context.Context, id string) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.CloseGenerator")
+	defer tracer.EndSpan(ctx)
+
+	return s.sessions.Close(ctx, id)
+}
+
+// SubmitJob queues a Monte Carlo job, GetJob reports its progress and result.
+func (s *RandomService) SubmitJob(ctx context.Context, spec entity.JobSpec) (*entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.SubmitJob")
+	defer tracer.EndSpan(ctx)
+
+	if _, err := s.repository(spec.Seed, entity.Seeded, spec.Algorithm); err != nil {
+		return nil, err
+	}
+	if err := validateJob(spec, s.config.Jobs.MaxSamples); err != nil {
+		return nil, err
+	}
+
+	job, err := s.jobs.Submit(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (s *RandomService) GetJob(ctx context.Context, id string) (*entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetJob")
+	defer tracer.EndSpan(ctx)
+
+	job, err := s.jobs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (s *RandomService) CancelJob(ctx context.Context, id string) (*entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.CancelJob")
+	defer tracer.EndSpan(ctx)
+
+	job, err := s.jobs.Cancel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// ListJobs returns the jobs in state, or every job when state is empty,
+// newest first.
+func (s *RandomService) ListJobs(ctx context.Context, state entity.JobState) ([]entity.Job, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.ListJobs")
+	defer tracer.EndSpan(ctx)
+
+	return s.jobs.List(ctx, state)
+}
+
+// ListDraws returns at most limit past draws selected by filter, oldest
+// first, from the page cursor points to.
+func (s *RandomService) ListDraws(ctx context.Context, filter entity.DrawFilter, cursor string, limit int) (*entity.DrawPage, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.ListDraws")
+	defer tracer.EndSpan(ctx)
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, errors.New("validate: from must be before to")
+	}
+	if limit < 1 {
+		return nil, errors.New("validate: page size must be positive")
+	}
+
+	page, err := s.draws.List(ctx, filter, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// GetUsage returns the quota usage of the client calling with ctx.
+func (s *RandomService) GetUsage(ctx context.Context) (*entity.Usage, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetUsage")
+	defer tracer.EndSpan(ctx)
+
+	usage, err := s.quotas.Usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// exportPageSize is the number of draws ExportDraws holds at once.
+const exportPageSize = 1000
+
+// ExportDraws calls send with every past draw selected by filter, oldest
+// first, reading them a page at a time.
+func (s *RandomService) ExportDraws(ctx context.Context, filter entity.DrawFilter, send func(entity.Draw) error) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.ExportDraws")
+	defer tracer.EndSpan(ctx)
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return errors.New("validate: from must be before to")
+	}
+
+	var cursor string
+	for {
+		page, err := s.draws.List(ctx, filter, cursor, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, draw := range page.Draws {
+			if err := send(draw); err != nil {
+				return err
+			}
+		}
+		if page.NextCursor == "" {
+			return nil
+		}
+		cursor = page.NextCursor
+	}
+}
+
+// NewDrawSession returns a session drawing from its own generator, which
+// starts unseeded.
+func (s *RandomService) NewDrawSession() entity.IDrawSession {
+	return newDrawSession(s.registry)
+}
+
+// CreateCommitment draws a secret from crypto/rand and returns the commitment
+// to it, the secret itself stays on the server until Draw reveals it.
+func (s *RandomService) CreateCommitment(ctx context.Context) (*entity.Commitment, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.CreateCommitment")
+	defer tracer.EndSpan(ctx)
+
+	secret, err := s.secureRepo.Read(ctx, 0, secretSize)
+	if err != nil {
+		return nil, err
+	}
+
+	commitment := entity.Commitment{
+		ID:        uuid.NewString(),
+		Hash:      fair.Commit(secret),
+		Secret:    secret,
+		ExpiresAt: time.Now().Add(s.config.Commitments.TTL),
+	}
+	if err := s.commitments.Create(ctx, commitment); err != nil {
+		return nil, err
+	}
+	commitment.Secret = nil
+
+	return &commitment, nil
+}
+
+// Draw combines the secret of the commitment id with clientSeed into a number
+// in bounds and reveals the secret. A commitment backs a single draw.
+func (s *RandomService) Draw(ctx context.Context, id string, clientSeed string, bounds entity.IntRange) (*entity.FairDraw, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.Draw")
+	defer tracer.EndSpan(ctx)
+
+	if len(clientSeed) < 1 || len(clientSeed) > maxClientSeedLength {
+		return nil, fmt.Errorf("validate: client seed must be between 1 and %d bytes", maxClientSeedLength)
+	}
+	if bounds.Min > bounds.Max {
+		return nil, errors.New("validate: min must be less than or equal to max")
+	}
+
+	commitment, err := s.commitments.Take(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.FairDraw{
+		Commitment: commitment,
+		ClientSeed: clientSeed,
+		Range:      bounds,
+		Number:     fair.Number(commitment.Secret, clientSeed, bounds.Min, bounds.Max),
+	}, nil
+}
+
+// GetPublicKey returns the public key keyID, an empty keyID is the key
+// signing new outputs.
+func (s *RandomService) GetPublicKey(ctx context.Context, keyID string) (*entity.PublicKey, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Usecase.GetPublicKey")
+	defer tracer.EndSpan(ctx)
+
+	id, key, err := s.signer.PublicKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.PublicKey{
+		KeyID:  id,
+		Key:    key,
+		Active: id == s.signer.ActiveKeyID(),
+	}, nil
+}
+
+// sign attaches the signature of output to randNum when signing is enabled.
+// Secure values ignore the seed, so it is signed as 0.
+func (s *RandomService) sign(randNum *entity.Random, seed int64, algorithm entity.Algorithm, bounds string, output string) error {
+	if !s.signer.Enabled() {
+		return nil
+	}
+	if randNum.Mode == entity.Secure {
+		seed = 0
+	}
+
+	payload, signature, err := s.signer.Sign(signing.Payload{
+		Seed:             seed,
+		Mode:             string(randNum.Mode),
+		Algorithm:        algorithm.Name,
+		AlgorithmVersion: algorithm.Version,
+		Range:            bounds,
+		Output:           output,
+		Timestamp:        time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	randNum.Signature = &entity.Signature{
+		KeyID:     payload.KeyID,
+		Timestamp: payload.Timestamp,
+		Value:     signature,
+	}
+
+	return nil
+}
+
+// read returns n random bytes generated in mode, every identifier format is built on it.
+func (s *RandomService) read(ctx context.Context, seed int64, mode entity.Mode, algorithm string, n int) ([]byte, error) {
+	repo, err := s.repository(seed, mode, algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	return repo.Read(ctx, seed, n)
+}
+
+// repository returns the repository generating values in mode with algorithm.
+// The seed is only validated when it drives the output.
+func (s *RandomService) repository(seed int64, mode entity.Mode, algorithm string) (entity.IRandomRepository, error) {
+	if mode == entity.Seeded {
+		if err := validateSeed(seed); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.lookup(mode, algorithm)
+}
+
+// lookup returns the repository generating values in mode with algorithm.
+// Secure values always come from crypto/rand, so algorithm must be empty.
+func (s *RandomService) lookup(mode entity.Mode, algorithm string) (entity.IRandomRepository, error) {
+	switch mode {
+	case entity.Seeded:
+		return s.registry.Get(algorithm)
+	case entity.Secure:
+		if algorithm != "" {
+			return nil, errors.New("validate: algorithm cannot be set in secure mode")
+		}
+		return s.secureRepo, nil
+	}
+
+	return nil, fmt.Errorf("validate: unknown mode %q", mode)
+}
+
+// minSeed is the smallest seed of seeded draws, the same bound the API
+// enforces on SeedNum.
+const minSeed = 3
+
+func validateSeed(seed int64) error {
+	if seed < minSeed {
+		return fmt.Errorf("validate: seed must be greater than or equal to %d", minSeed)
+	}
+
+	return nil
+}
+
+func validateWeights(weights []float64) error {
+	if len(weights) < 1 || len(weights) > maxChoiceItems {
+		return fmt.Errorf("validate: the number of weights must be between 1 and %d", maxChoiceItems)
+	}
+
+	sum := 0.0
+	for _, w := range weights {
+		if !isFinite(w) || w < 0 {
+			return errors.New("validate: weights must be finite and non-negative")
+		}
+		sum += w
+	}
+	if sum <= 0 {
+		return errors.New("validate: at least one weight must be positive")
+	}
+	if !isFinite(sum) {
+		return errors.New("validate: the sum of the weights must be finite")
+	}
+
+	return nil
+}
+
+func validateDistribution(dist entity.Distribution) error {
+	switch dist.Kind {
+	case entity.Normal:
+		if !isFinite(dist.Mean) || !isFinite(dist.StdDev) || dist.StdDev < 0 {
+			return errors.New("validate: normal distribution needs a finite mean and a finite, non-negative stddev")
+		}
+	case entity.Exponential:
+		if !isFinite(dist.Rate) || dist.Rate <= 0 {
+			return errors.New("validate: exponential distribution needs a finite, positive rate")
+		}
+	case entity.Poisson:
+		if !(dist.Lambda > 0 && dist.Lambda <= 1e12) {
+			return errors.New("validate: poisson distribution needs a lambda in (0, 1e12]")
+		}
+	case entity.Binomial:
+		if dist.N < 0 || dist.N > 1e12 {
+			return errors.New("validate: binomial distribution needs n in [0, 1e12]")
+		}
+		if !(dist.P >= 0 && dist.P <= 1) {
+			return errors.New("validate: binomial distribution needs p in [0, 1]")
+		}
+	case entity.Uniform:
+		if !isFinite(dist.Min) || !isFinite(dist.Max) || dist.Min > dist.Max {
+			return errors.New("validate: uniform distribution needs finite bounds with min less than or equal to max")
+		}
+	default:
+		return fmt.Errorf("validate: unknown distribution %q", dist.Kind)
+	}
+
+	return nil
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
@@ -1 +1,540 @@
 package random
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/journal"
+	"github.com/minhthong582000/soa-404/pkg/signing"
+)
+
+func newTestService() *RandomService {
+	// The draw history is disabled unless a test replaces it
+	draws, err := journal.Open(&config.Journal{})
+	if err != nil {
+		panic(err)
+	}
+
+	config := &config.Random{
+		MaxBatchSize:     10,
+		DefaultAlgorithm: Legacy,
+		Sessions: config.Sessions{
+			TTL:         time.Minute,
+			MaxSessions: 10,
+		},
+		Commitments: config.Commitments{
+			TTL:            time.Minute,
+			MaxCommitments: 10,
+		},
+		Jobs: config.Jobs{
+			Workers:    2,
+			QueueSize:  10,
+			MaxJobs:    10,
+			Retention:  time.Minute,
+			MaxSamples: 1000000,
+		},
+	}
+	registry, err := NewRegistry(config)
+	if err != nil {
+		panic(err)
+	}
+	signer, err := signing.NewSigner(&config.Signing)
+	if err != nil {
+		panic(err)
+	}
+	usage, err := NewUsageRepository(&config.Quotas)
+	if err != nil {
+		panic(err)
+	}
+	quotas, err := NewQuotas(&config.Quotas, usage)
+	if err != nil {
+		panic(err)
+	}
+
+	return NewService(registry, NewSecureRepository(), NewSessionRepository(registry, &config.Sessions), NewCommitmentRepository(&config.Commitments), NewJobRepository(registry, &config.Jobs), NewDrawRepository(draws), quotas, signer, config)
+}
+
+func TestRandomService_Stream(t *testing.T) {
+	tests := []struct {
+		name        string
+		seed        int64
+		count       int64
+		expectError bool
+		expectCount int
+	}{
+		{
+			name:        "Valid Request",
+			seed:        42,
+			count:       3,
+			expectCount: 3,
+		},
+		{
+			name:        "Invalid Seed",
+			seed:        1,
+			count:       3,
+			expectError: true,
+		},
+		{
+			name:        "Negative Count",
+			seed:        42,
+			count:       -1,
+			expectError: true,
+		},
+	}
+
+	service := newTestService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := 0
+			err := service.Stream(context.Background(), tt.seed, "", tt.count, func(randNum entity.Random) error {
+				got++
+				return nil
+			})
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "validate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectCount, got)
+		})
+	}
+}
+
+func TestRandomService_GetBatch(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	results, err := service.GetBatch(ctx, []int64{42, 1, 43}, "")
+	assert.NoError(t, err)
+	assert.Len(t, results, 3)
+
+	for i, seed := range []int64{42, 1, 43} {
+		assert.Equal(t, seed, results[i].Seed, "Expected results in request order")
+	}
+	assert.Error(t, results[1].Err, "Expected a per-item error for an invalid seed")
+	assert.NoError(t, results[0].Err)
+	assert.NoError(t, results[2].Err)
+
+	single, err := service.Get(ctx, 43, entity.Seeded, "")
+	assert.NoError(t, err)
+	assert.Equal(t, single.Number, results[2].Random.Number, "Expected batch items to match single draws")
+
+	// Batch items take the seeds single draws take
+	results, err = service.GetBatch(ctx, []int64{2, 3}, "")
+	assert.NoError(t, err)
+	assert.EqualError(t, results[0].Err, "validate: seed must be greater than or equal to 3")
+	_, err = service.Get(ctx, 2, entity.Seeded, "")
+	assert.Error(t, err)
+	assert.NoError(t, results[1].Err)
+	_, err = service.Get(ctx, 3, entity.Seeded, "")
+	assert.NoError(t, err)
+}
+
+func TestRandomService_GetBatchTooLarge(t *testing.T) {
+	service := newTestService()
+
+	_, err := service.GetBatch(context.Background(), make([]int64, 11), "")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "validate")
+}
+
+func TestRandomService_GetBatchCancelled(t *testing.T) {
+	service := newTestService()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := service.GetBatch(ctx, []int64{42, 43}, "")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestRandomService_GetInRangeInvalidBounds(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	_, err := service.GetInIntRange(ctx, 42, "", entity.IntRange{Min: 10, Max: 1})
+	assert.ErrorContains(t, err, "validate")
+
+	_, err = service.GetInFloatRange(ctx, 42, "", entity.FloatRange{Min: 1, Max: 0.5})
+	assert.ErrorContains(t, err, "validate")
+
+	_, err = service.GetInFloatRange(ctx, 42, "", entity.FloatRange{Min: 0, Max: math.Inf(1)})
+	assert.ErrorContains(t, err, "validate")
+}
+
+func TestRandomService_Sample(t *testing.T) {
+	tests := []struct {
+		name        string
+		dist        entity.Distribution
+		count       int64
+		expectError bool
+	}{
+		{name: "Valid Normal", dist: entity.Distribution{Kind: entity.Normal, StdDev: 1}, count: 10},
+		{name: "Negative StdDev", dist: entity.Distribution{Kind: entity.Normal, StdDev: -1}, count: 10, expectError: true},
+		{name: "Zero Rate", dist: entity.Distribution{Kind: entity.Exponential}, count: 10, expectError: true},
+		{name: "NaN Lambda", dist: entity.Distribution{Kind: entity.Poisson, Lambda: math.NaN()}, count: 10, expectError: true},
+		{name: "Probability Above One", dist: entity.Distribution{Kind: entity.Binomial, N: 10, P: 1.5}, count: 10, expectError: true},
+		{name: "Inverted Uniform", dist: entity.Distribution{Kind: entity.Uniform, Min: 1, Max: 0}, count: 10, expectError: true},
+		{name: "Unknown Kind", dist: entity.Distribution{Kind: "cauchy"}, count: 10, expectError: true},
+		{name: "Zero Count", dist: entity.Distribution{Kind: entity.Normal, StdDev: 1}, count: 0, expectError: true},
+	}
+
+	service := newTestService()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples, err := service.Sample(context.Background(), 42, "", tt.dist, tt.count)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, samples, int(tt.count))
+
+			again, err := service.Sample(context.Background(), 42, "", tt.dist, tt.count)
+			assert.NoError(t, err)
+			assert.Equal(t, samples, again, "Expected the same seed to produce the same samples")
+		})
+	}
+}
+
+func TestRandomService_GetModes(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	seeded, err := service.Get(ctx, 42, entity.Seeded, "")
+	assert.NoError(t, err)
+	assert.Equal(t, entity.Seeded, seeded.Mode)
+
+	again, err := service.Get(ctx, 42, entity.Seeded, "")
+	assert.NoError(t, err)
+	assert.Equal(t, seeded.Number, again.Number, "Expected seeded mode to be reproducible")
+
+	_, err = service.Get(ctx, 0, entity.Seeded, "")
+	assert.ErrorContains(t, err, "validate", "Expected seeded mode to validate the seed")
+
+	secure, err := service.Get(ctx, 0, entity.Secure, "")
+	assert.NoError(t, err, "Expected secure mode to ignore the seed")
+	assert.Equal(t, entity.Secure, secure.Mode)
+
+	_, err = service.Get(ctx, 42, "quantum", "")
+	assert.ErrorContains(t, err, "validate")
+}
+
+func TestRandomService_Identifiers(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	b, err := service.GetBytes(ctx, 42, entity.Seeded, "", 13)
+	assert.NoError(t, err)
+	assert.Len(t, b, 13)
+	again, err := service.GetBytes(ctx, 42, entity.Seeded, "", 13)
+	assert.NoError(t, err)
+	assert.Equal(t, b, again, "Expected seeded bytes to be reproducible")
+
+	uuids, err := service.GetUUIDs(ctx, 42, entity.Seeded, "", 4, 3)
+	assert.NoError(t, err)
+	assert.Len(t, uuids, 3)
+	assert.NotEqual(t, uuids[0], uuids[1])
+	_, err = service.GetUUIDs(ctx, 42, entity.Seeded, "", 5, 1)
+	assert.ErrorContains(t, err, "validate")
+
+	token, err := service.GetToken(ctx, 0, entity.Secure, "", 20)
+	assert.NoError(t, err)
+	assert.Len(t, token, 20)
+	_, err = service.GetToken(ctx, 0, entity.Seeded, "", 20)
+	assert.ErrorContains(t, err, "validate", "Expected seeded mode to validate the seed")
+}
+
+func TestRandomService_GetAt(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	sequence, err := service.GetAt(ctx, 42, PCG, 1000000, 3)
+	assert.NoError(t, err)
+	assert.Len(t, sequence.Numbers, 3)
+	assert.Equal(t, int64(1000000), sequence.Index)
+
+	_, err = service.GetAt(ctx, 42, PCG, -1, 1)
+	assert.ErrorContains(t, err, "validate")
+	_, err = service.GetAt(ctx, 42, PCG, 0, maxSequenceCount+1)
+	assert.ErrorContains(t, err, "validate")
+	_, err = service.GetAt(ctx, 42, Legacy, 0, 1)
+	assert.ErrorContains(t, err, "cannot jump ahead")
+}
+
+func TestRandomService_Algorithm(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	algorithm, err := service.Algorithm(entity.Seeded, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Legacy, algorithm.Name, "Expected an empty name to select the default algorithm")
+
+	legacy, err := service.Get(ctx, 42, entity.Seeded, Legacy)
+	assert.NoError(t, err)
+	pcg, err := service.Get(ctx, 42, entity.Seeded, PCG)
+	assert.NoError(t, err)
+	assert.NotEqual(t, legacy.Number, pcg.Number, "Expected algorithms to generate different sequences")
+
+	_, err = service.Get(ctx, 42, entity.Seeded, "mt19937")
+	assert.ErrorContains(t, err, "validate")
+
+	algorithm, err = service.Algorithm(entity.Secure, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "crypto/rand", algorithm.Name)
+	_, err = service.Get(ctx, 0, entity.Secure, PCG)
+	assert.ErrorContains(t, err, "validate", "Expected secure mode to reject an algorithm")
+}
+
+func TestRandomService_Choose(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	indices, err := service.Choose(ctx, 42, "", []float64{0, 3, 0, 1}, 100)
+	assert.NoError(t, err)
+	assert.Len(t, indices, 100)
+	for _, i := range indices {
+		assert.Contains(t, []int64{1, 3}, i, "Expected items without weight to never be picked")
+	}
+
+	again, err := service.Choose(ctx, 42, "", []float64{0, 3, 0, 1}, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, indices, again, "Expected the same seed to produce the same picks")
+
+	tests := []struct {
+		name    string
+		weights []float64
+	}{
+		{name: "No Weights", weights: nil},
+		{name: "Negative Weight", weights: []float64{1, -1}},
+		{name: "Zero Weights", weights: []float64{0, 0}},
+		{name: "NaN Weight", weights: []float64{1, math.NaN()}},
+		{name: "Infinite Sum", weights: []float64{math.MaxFloat64, math.MaxFloat64}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Choose(ctx, 42, "", tt.weights, 1)
+			assert.ErrorContains(t, err, "validate")
+		})
+	}
+}
+
+func TestRandomService_GetStrings(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		seed        int64
+		mode        entity.Mode
+		spec        entity.StringSpec
+		count       int
+		expectError bool
+		expectMatch string
+	}{
+		{
+			name:        "Pattern",
+			seed:        42,
+			mode:        entity.Seeded,
+			spec:        entity.StringSpec{Pattern: "[A-Z]{3}-[0-9]{4}"},
+			count:       5,
+			expectMatch: `^[A-Z]{3}-[0-9]{4}$`,
+		},
+		{
+			name:        "Charset",
+			seed:        42,
+			mode:        entity.Seeded,
+			spec:        entity.StringSpec{Charset: "a-f0-9", Length: 12},
+			count:       5,
+			expectMatch: `^[a-f0-9]{12}$`,
+		},
+		{
+			name:        "Secure",
+			mode:        entity.Secure,
+			spec:        entity.StringSpec{Pattern: `\w{16}`},
+			count:       1,
+			expectMatch: `^\w{16}$`,
+		},
+		{name: "Pattern And Charset", seed: 42, mode: entity.Seeded, spec: entity.StringSpec{Pattern: "a", Charset: "a", Length: 1}, count: 1, expectError: true},
+		{name: "Neither", seed: 42, mode: entity.Seeded, count: 1, expectError: true},
+		{name: "Charset Without Length", seed: 42, mode: entity.Seeded, spec: entity.StringSpec{Charset: "a-z"}, count: 1, expectError: true},
+		{name: "Invalid Pattern", seed: 42, mode: entity.Seeded, spec: entity.StringSpec{Pattern: "[A-Z"}, count: 1, expectError: true},
+		{name: "Too Many", seed: 42, mode: entity.Seeded, spec: entity.StringSpec{Pattern: "a"}, count: maxStringCount + 1, expectError: true},
+		{name: "Invalid Seed", seed: 1, mode: entity.Seeded, spec: entity.StringSpec{Pattern: "a"}, count: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := service.GetStrings(ctx, tt.seed, tt.mode, "", tt.spec, tt.count)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, values, tt.count)
+			for _, value := range values {
+				assert.Regexp(t, tt.expectMatch, value)
+			}
+		})
+	}
+}
+
+func TestRandomService_GenerateRecords(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+	schema := []entity.FieldSpec{{Name: "id", Kind: entity.UUIDField}}
+	discard := func(entity.Record) error { return nil }
+
+	tests := []struct {
+		name        string
+		seed        int64
+		algorithm   string
+		schema      []entity.FieldSpec
+		count       int64
+		expectError bool
+	}{
+		{name: "Valid", seed: 42, schema: schema, count: 3},
+		{name: "Algorithm", seed: 42, algorithm: "pcg", schema: schema, count: 3},
+		{name: "No Records", seed: 42, schema: schema, count: 0, expectError: true},
+		{name: "Too Many", seed: 42, schema: schema, count: maxRecordCount + 1, expectError: true},
+		{name: "Invalid Schema", seed: 42, count: 3, expectError: true},
+		{name: "Invalid Seed", seed: 1, schema: schema, count: 3, expectError: true},
+		{name: "Unknown Algorithm", seed: 42, algorithm: "mt19937", schema: schema, count: 3, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.GenerateRecords(ctx, tt.seed, tt.algorithm, tt.schema, tt.count, discard)
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	sendErr := errors.New("client gone")
+	sent := 0
+	err := service.GenerateRecords(ctx, 42, "", schema, 10, func(entity.Record) error {
+		sent++
+		return sendErr
+	})
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, sent, "Expected generation to stop at the first send error")
+}
+
+func TestRandomService_SubmitJob(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		spec        entity.JobSpec
+		expectError bool
+	}{
+		{name: "Pi", spec: entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: 1000}},
+		{name: "Integral", spec: entity.JobSpec{Kind: entity.IntegralJob, Seed: 42, Samples: 1000, Expression: "4 / (1 + x^2)", Range: entity.FloatRange{Min: 0, Max: 1}}},
+		{name: "Invalid Seed", spec: entity.JobSpec{Kind: entity.PiJob, Seed: 1, Samples: 1000}, expectError: true},
+		{name: "Unknown Algorithm", spec: entity.JobSpec{Kind: entity.PiJob, Seed: 42, Algorithm: "mt19937", Samples: 1000}, expectError: true},
+		{name: "Too Many Samples", spec: entity.JobSpec{Kind: entity.PiJob, Seed: 42, Samples: service.config.Jobs.MaxSamples + 1}, expectError: true},
+		{name: "Invalid Expression", spec: entity.JobSpec{Kind: entity.IntegralJob, Seed: 42, Samples: 1000, Expression: "y", Range: entity.FloatRange{Min: 0, Max: 1}}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := service.SubmitJob(ctx, tt.spec)
+			if tt.expectError {
+				assert.ErrorContains(t, err, "validate")
+				return
+			}
+			assert.NoError(t, err)
+
+			got, err := service.GetJob(ctx, job.ID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.spec, got.Spec)
+		})
+	}
+}
+
+func TestRandomService_ListDraws(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+
+	_, err := service.ListDraws(ctx, entity.DrawFilter{}, "", 10)
+	assert.ErrorIs(t, err, grpc_errors.ErrDisabled)
+
+	repo, start := newTestDrawRepository(t)
+	service.draws = repo
+	saveTestDraws(t, repo, start, 3)
+
+	page, err := service.ListDraws(ctx, entity.DrawFilter{Seed: 2}, "", 10)
+	assert.NoError(t, err)
+	assert.Len(t, page.Draws, 2)
+
+	tests := []struct {
+		name   string
+		filter entity.DrawFilter
+		limit  int
+	}{
+		{
+			name:   "Empty time range",
+			filter: entity.DrawFilter{From: start, To: start},
+			limit:  10,
+		},
+		{
+			name:   "Reversed time range",
+			filter: entity.DrawFilter{From: start.Add(time.Second), To: start},
+			limit:  10,
+		},
+		{
+			name:  "No page size",
+			limit: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := service.ListDraws(ctx, tc.filter, "", tc.limit)
+			assert.ErrorContains(t, err, "validate:")
+		})
+	}
+}
+
+func TestRandomService_ExportDraws(t *testing.T) {
+	service := newTestService()
+	ctx := context.Background()
+	send := func(entity.Draw) error { return nil }
+
+	assert.ErrorIs(t, service.ExportDraws(ctx, entity.DrawFilter{}, send), grpc_errors.ErrDisabled)
+
+	repo, start := newTestDrawRepository(t)
+	service.draws = repo
+	saveTestDraws(t, repo, start, 5)
+
+	var sequences []uint64
+	err := service.ExportDraws(ctx, entity.DrawFilter{From: start.Add(time.Second), To: start.Add(4 * time.Second)}, func(draw entity.Draw) error {
+		sequences = append(sequences, draw.Sequence)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []uint64{2, 3, 4}, sequences)
+
+	failed := errors.New("client went away")
+	calls := 0
+	err = service.ExportDraws(ctx, entity.DrawFilter{}, func(entity.Draw) error {
+		calls++
+		return failed
+	})
+	assert.ErrorIs(t, err, failed)
+	assert.Equal(t, 1, calls, "Expected the export to stop at the first failed send")
+
+	err = service.ExportDraws(ctx, entity.DrawFilter{From: start, To: start}, send)
+	assert.ErrorContains(t, err, "validate:")
+}
@@ -0,0 +1,196 @@
+package random
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// SessionRepo keeps seeded generators alive between calls. Sessions idle for
+// longer than the TTL are evicted, and at most MaxSessions exist at once.
+type SessionRepo struct {
+	registry *Registry
+	config   *config.Sessions
+	now      func() time.Time
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+type session struct {
+	// mu serializes draws so that position always matches the values handed out
+	mu       sync.Mutex
+	rand     *rand.Rand
+	position int64
+	// lastUsed is guarded by SessionRepo.mu
+	lastUsed time.Time
+}
+
+func NewSessionRepository(registry *Registry, config *config.Sessions) *SessionRepo {
+	return &SessionRepo{
+		registry: registry,
+		config:   config,
+		now:      time.Now,
+		sessions: make(map[string]*session),
+	}
+}
+
+func (r *SessionRepo) Create(ctx context.Context, seed int64, algorithm string) (entity.Generator, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CreateGenerator")
+	defer tracer.EndSpan(ctx)
+
+	repo, err := r.registry.repo(algorithm)
+	if err != nil {
+		return entity.Generator{}, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Expired sessions must not hold slots until the next sweep
+	if len(r.sessions) >= r.config.MaxSessions {
+		r.evictExpired()
+	}
+	if len(r.sessions) >= r.config.MaxSessions {
+		return entity.Generator{}, fmt.Errorf("%d generator sessions are open, close some before creating more: %w", len(r.sessions), grpc_errors.ErrExhausted)
+	}
+
+	id := uuid.NewString()
+	r.sessions[id] = &session{
+		rand:     repo.newRand(seed),
+		lastUsed: r.now(),
+	}
+	r.reportActive()
+
+	return entity.Generator{
+		ID:          id,
+		Seed:        seed,
+		Algorithm:   repo.Algorithm(),
+		IdleTimeout: r.config.TTL,
+	}, nil
+}
+
+func (r *SessionRepo) Next(ctx context.Context, id string, count int) (entity.Sequence, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.Next")
+	defer tracer.EndSpan(ctx)
+
+	s, err := r.get(id)
+	if err != nil {
+		return entity.Sequence{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	numbers := make([]int64, 0, count)
+	for i := 0; i < count; i++ {
+		numbers = append(numbers, s.rand.Int63())
+	}
+	index := s.position
+	s.position += int64(count)
+
+	return entity.Sequence{
+		Numbers: numbers,
+		Index:   index,
+	}, nil
+}
+
+func (r *SessionRepo) Close(ctx context.Context, id string) error {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.CloseGenerator")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sessions[id]; !ok {
+		return fmt.Errorf("generator %s: %w", id, grpc_errors.ErrNotFound)
+	}
+	delete(r.sessions, id)
+	r.reportActive()
+
+	return nil
+}
+
+// Run evicts idle sessions periodically until stopCh is closed.
+func (r *SessionRepo) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(min(r.config.TTL, time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			r.mu.Lock()
+			r.evictExpired()
+			r.mu.Unlock()
+		}
+	}
+}
+
+// get returns the session id and marks it as used.
+func (r *SessionRepo) get(id string) (*session, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s, ok := r.sessions[id]
+	if !ok {
+		return nil, fmt.Errorf("generator %s: %w", id, grpc_errors.ErrNotFound)
+	}
+
+	now := r.now()
+	if now.Sub(s.lastUsed) > r.config.TTL {
+		delete(r.sessions, id)
+		r.reportEvicted(1)
+		return nil, fmt.Errorf("generator %s expired: %w", id, grpc_errors.ErrNotFound)
+	}
+	s.lastUsed = now
+
+	return s, nil
+}
+
+// evictExpired removes the sessions idle for longer than the TTL, r.mu must be held.
+func (r *SessionRepo) evictExpired() {
+	now := r.now()
+	evicted := 0
+	for id, s := range r.sessions {
+		if now.Sub(s.lastUsed) > r.config.TTL {
+			delete(r.sessions, id)
+			evicted++
+		}
+	}
+
+	if evicted > 0 {
+		r.reportEvicted(evicted)
+	}
+}
+
+// reportEvicted records evicted sessions, r.mu must be held.
+func (r *SessionRepo) reportEvicted(evicted int) {
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(metric.Random_generator_sessions_evicted_total.Name) {
+		_ = metr.Counter(metric.Random_generator_sessions_evicted_total, float64(evicted))
+	}
+	r.reportActive()
+}
+
+// reportActive records the number of sessions, r.mu must be held.
+func (r *SessionRepo) reportActive() {
+	metr := metric.GetMetric()
+	if metr.IsMetricExist(metric.Random_generator_sessions_active.Name) {
+		_ = metr.SetGauge(metric.Random_generator_sessions_active, float64(len(r.sessions)))
+	}
+}
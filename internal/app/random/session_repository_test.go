@@ -0,0 +1,136 @@
+package random
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/internal/entity"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+func newTestSessionRepository(t *testing.T, maxSessions int) (*SessionRepo, *time.Time) {
+	registry, err := NewRegistry(&config.Random{DefaultAlgorithm: Legacy})
+	assert.NoError(t, err)
+
+	now := time.Unix(0, 0)
+	repo := NewSessionRepository(registry, &config.Sessions{
+		TTL:         time.Minute,
+		MaxSessions: maxSessions,
+	})
+	repo.now = func() time.Time {
+		return now
+	}
+
+	return repo, &now
+}
+
+func TestSessionRepo_ContinuesSequence(t *testing.T) {
+	repo, _ := newTestSessionRepository(t, 10)
+	ctx := context.Background()
+
+	var streamed []int64
+	err := NewRepository().Stream(ctx, 42, 10, func(randNum entity.Random) error {
+		streamed = append(streamed, randNum.Number)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	generator, err := repo.Create(ctx, 42, "")
+	assert.NoError(t, err)
+	assert.Equal(t, Legacy, generator.Algorithm.Name)
+
+	first, err := repo.Next(ctx, generator.ID, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), first.Index)
+	second, err := repo.Next(ctx, generator.ID, 6)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), second.Index)
+	assert.Equal(t, streamed, append(first.Numbers, second.Numbers...), "Expected calls to continue the same sequence")
+
+	assert.NoError(t, repo.Close(ctx, generator.ID))
+	_, err = repo.Next(ctx, generator.ID, 1)
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+	assert.ErrorIs(t, repo.Close(ctx, generator.ID), grpc_errors.ErrNotFound)
+}
+
+func TestSessionRepo_IdleTimeout(t *testing.T) {
+	repo, now := newTestSessionRepository(t, 10)
+	ctx := context.Background()
+
+	generator, err := repo.Create(ctx, 42, "")
+	assert.NoError(t, err)
+
+	// Every call pushes the expiry back
+	*now = now.Add(50 * time.Second)
+	_, err = repo.Next(ctx, generator.ID, 1)
+	assert.NoError(t, err)
+	*now = now.Add(50 * time.Second)
+	_, err = repo.Next(ctx, generator.ID, 1)
+	assert.NoError(t, err)
+
+	*now = now.Add(61 * time.Second)
+	_, err = repo.Next(ctx, generator.ID, 1)
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound, "Expected an idle generator to expire")
+	assert.Empty(t, repo.sessions)
+}
+
+func TestSessionRepo_MaxSessions(t *testing.T) {
+	repo, now := newTestSessionRepository(t, 2)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := repo.Create(ctx, 42, "")
+		assert.NoError(t, err)
+	}
+	_, err := repo.Create(ctx, 42, "")
+	assert.ErrorIs(t, err, grpc_errors.ErrExhausted)
+
+	// Expired sessions free their slot
+	*now = now.Add(2 * time.Minute)
+	_, err = repo.Create(ctx, 42, "")
+	assert.NoError(t, err)
+	assert.Len(t, repo.sessions, 1)
+
+	_, err = repo.Create(ctx, 42, "mt19937")
+	assert.ErrorContains(t, err, "validate")
+}
+
+func TestSessionRepo_Concurrent(t *testing.T) {
+	repo, _ := newTestSessionRepository(t, 10)
+	ctx := context.Background()
+
+	generator, err := repo.Create(ctx, 42, "")
+	assert.NoError(t, err)
+
+	const workers, calls, count = 8, 50, 3
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		indices = map[int64]bool{}
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < calls; i++ {
+				sequence, err := repo.Next(ctx, generator.ID, count)
+				assert.NoError(t, err)
+
+				mu.Lock()
+				indices[sequence.Index] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, indices, workers*calls, "Expected every call to get its own slice of the sequence")
+	last, err := repo.Next(ctx, generator.ID, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(workers*calls*count), last.Index)
+}
@@ -0,0 +1,151 @@
+package random
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/entity"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/signing"
+)
+
+// ReplySigner signs the draws served over gRPC that the service leaves
+// unsigned, that is every draw but those of GetRandNumber and
+// GetRandNumberInRange. Its interceptors sign every successful reply, or
+// every message of a stream, over signing.Message of the reply, along with the
+// seed and the mode of the request, see replyOrigin.
+type ReplySigner struct {
+	signer *signing.Signer
+	now    func() time.Time
+}
+
+func NewReplySigner(signer *signing.Signer) *ReplySigner {
+	return &ReplySigner{
+		signer: signer,
+		now:    time.Now,
+	}
+}
+
+// Unary signs the reply of draws.
+func (r *ReplySigner) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	reply, err := handler(ctx, req)
+	if err != nil || !r.signed(info.FullMethod) {
+		return reply, err
+	}
+
+	if err := r.sign(req, reply); err != nil {
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// Stream signs every message draw streams send.
+func (r *ReplySigner) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !r.signed(info.FullMethod) {
+		return handler(srv, ss)
+	}
+
+	return handler(srv, &signedStream{
+		ServerStream: ss,
+		signer:       r,
+	})
+}
+
+func (r *ReplySigner) signed(fullMethod string) bool {
+	_, method := grpcUtils.SplitMethodName(fullMethod)
+	return journaledMethods[method]
+}
+
+// sign sets the Signature of reply, unless it has none or is already signed.
+func (r *ReplySigner) sign(request, reply interface{}) error {
+	message, ok := reply.(proto.Message)
+	if !ok {
+		return nil
+	}
+	m := message.ProtoReflect()
+	field := m.Descriptor().Fields().ByName("Signature")
+	if field == nil || m.Has(field) {
+		return nil
+	}
+
+	output, err := signing.Message(message)
+	if err != nil {
+		return err
+	}
+	payload := signing.Payload{Output: output, Timestamp: r.now()}
+	payload.Seed, payload.Mode = replyOrigin(request)
+	if a, ok := reply.(interface {
+		GetAlgorithm() string
+		GetAlgorithmVersion() string
+	}); ok {
+		payload.Algorithm, payload.AlgorithmVersion = a.GetAlgorithm(), a.GetAlgorithmVersion()
+	}
+
+	payload, signature, err := r.signer.Sign(payload)
+	if err != nil {
+		return err
+	}
+	m.Set(field, protoreflect.ValueOfMessage((&pb.Signature{
+		KeyID:     payload.KeyID,
+		Timestamp: payload.Timestamp.UnixNano(),
+		Value:     signature,
+	}).ProtoReflect()))
+
+	return nil
+}
+
+// replyOrigin returns the seed and the mode signed along with a reply to
+// request: 0 and secure in MODE_SECURE, the seed of the request and seeded
+// when it has a single seed, 0 and an empty mode otherwise, e.g. for batches
+// and generators. client.VerifyReply mirrors it.
+func replyOrigin(request interface{}) (int64, string) {
+	if r, ok := request.(interface{ GetMode() pb.Mode }); ok && r.GetMode() == pb.Mode_MODE_SECURE {
+		return 0, string(entity.Secure)
+	}
+	if r, ok := request.(interface{ GetSeedNum() int64 }); ok {
+		return r.GetSeedNum(), string(entity.Seeded)
+	}
+
+	return 0, ""
+}
+
+// signedStream signs the messages sent on a stream along with the request
+// they answer.
+type signedStream struct {
+	grpc.ServerStream
+	signer *ReplySigner
+	// request is the last message received, or the last reseed command of a
+	// draw session
+	request interface{}
+}
+
+func (s *signedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+
+	if command, ok := m.(*pb.DrawCommand); ok {
+		if reseed := command.GetReseed(); reseed != nil {
+			s.request = reseed
+		}
+	} else {
+		s.request = m
+	}
+
+	return nil
+}
+
+func (s *signedStream) SendMsg(m interface{}) error {
+	if err := s.signer.sign(s.request, m); err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,71 @@
+package random
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/internal/app/client"
+)
+
+func TestReplySigner(t *testing.T) {
+	signer := newTestSigner(t)
+	_, publicKey, err := signer.PublicKey("")
+	assert.NoError(t, err)
+
+	service := newTestService()
+	service.signer = signer
+	replySigner := NewReplySigner(signer)
+	randClient := newTestServiceClient(t, service,
+		grpc.ChainUnaryInterceptor(replySigner.Unary),
+		grpc.ChainStreamInterceptor(replySigner.Stream),
+	)
+	ctx := context.Background()
+
+	// The service signs the number itself, the interceptor leaves it alone
+	numberRequest := &pb.GetRandNumberRequest{SeedNum: 42}
+	number, err := randClient.GetRandNumber(ctx, numberRequest)
+	assert.NoError(t, err)
+	assert.NoError(t, client.VerifyRandNumber(publicKey, 42, number))
+
+	shuffleRequest := &pb.ShuffleRequest{SeedNum: 42, Population: &pb.ShuffleRequest_Size{Size: 10}}
+	shuffle, err := randClient.Shuffle(ctx, shuffleRequest)
+	assert.NoError(t, err)
+	assert.NoError(t, client.VerifyReply(publicKey, shuffleRequest, shuffle))
+	assert.Error(t, client.VerifyReply(publicKey, &pb.ShuffleRequest{SeedNum: 43}, shuffle), "Expected a different seed to fail verification")
+	shuffle.Indices[0], shuffle.Indices[1] = shuffle.Indices[1], shuffle.Indices[0]
+	assert.Error(t, client.VerifyReply(publicKey, shuffleRequest, shuffle), "Expected an altered reply to fail verification")
+
+	bytesRequest := &pb.GetRandBytesRequest{Mode: pb.Mode_MODE_SECURE, Length: 16}
+	bytes, err := randClient.GetRandBytes(ctx, bytesRequest)
+	assert.NoError(t, err)
+	assert.NoError(t, client.VerifyReply(publicKey, bytesRequest, bytes))
+
+	streamRequest := &pb.StreamRandNumbersRequest{SeedNum: 42, Count: 3}
+	stream, err := randClient.StreamRandNumbers(ctx, streamRequest)
+	assert.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		reply, err := stream.Recv()
+		assert.NoError(t, err)
+		assert.NoError(t, client.VerifyReply(publicKey, streamRequest, reply), "Expected message %d to verify", i)
+	}
+
+	session, err := randClient.DrawSession(ctx)
+	assert.NoError(t, err)
+	reseed := &pb.ReseedCommand{SeedNum: 7}
+	for _, command := range []*pb.DrawCommand{
+		{ID: 1, Command: &pb.DrawCommand_Reseed{Reseed: reseed}},
+		{ID: 2, Command: &pb.DrawCommand_NextInt{NextInt: &pb.NextIntCommand{Count: 2}}},
+	} {
+		assert.NoError(t, session.Send(command))
+		result, err := session.Recv()
+		assert.NoError(t, err)
+		assert.NoError(t, client.VerifyReply(publicKey, reseed, result), "Expected the result of command %d to verify", command.ID)
+	}
+	assert.NoError(t, session.CloseSend())
+
+	assert.Error(t, client.VerifyReply(publicKey, numberRequest, &pb.GetPublicKeyReply{}), "Expected replies without signatures to be rejected")
+}
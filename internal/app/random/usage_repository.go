@@ -0,0 +1,186 @@
+package random
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/log"
+	"github.com/minhthong582000/soa-404/pkg/tracing"
+)
+
+// usageCount is a count kept by UsageRepo.
+type usageCount struct {
+	Count     int64     `json:"count"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// UsageRepo keeps usage counts in memory and writes them to the file at Path
+// every FlushInterval, so they survive restarts. Counts added since the last
+// write are lost on a crash.
+type UsageRepo struct {
+	config *config.Quotas
+	now    func() time.Time
+
+	mu     sync.Mutex
+	counts map[string]usageCount
+	dirty  bool
+	// flushMu keeps an older snapshot from replacing a newer one
+	flushMu sync.Mutex
+}
+
+// NewUsageRepository returns a repository with the counts written to the
+// file at Path, if any.
+func NewUsageRepository(config *config.Quotas) (*UsageRepo, error) {
+	repo := &UsageRepo{
+		config: config,
+		now:    time.Now,
+		counts: make(map[string]usageCount),
+	}
+	if config.Path == "" {
+		return repo, nil
+	}
+
+	data, err := os.ReadFile(config.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return repo, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &repo.counts); err != nil {
+		return nil, fmt.Errorf("invalid usage file %s: %w", config.Path, err)
+	}
+	repo.evict()
+
+	return repo, nil
+}
+
+func (r *UsageRepo) Get(ctx context.Context, key string) (int64, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.GetUsage")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count, ok := r.counts[key]
+	if !ok || !r.now().Before(count.ExpiresAt) {
+		return 0, nil
+	}
+
+	return count.Count, nil
+}
+
+func (r *UsageRepo) Add(ctx context.Context, key string, n int64, expiresAt time.Time) (int64, error) {
+	tracer := tracing.GetTracer()
+	ctx = tracer.StartSpan(ctx, "RandomService.Repository.AddUsage")
+	defer tracer.EndSpan(ctx)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	count, ok := r.counts[key]
+	if !ok || !r.now().Before(count.ExpiresAt) {
+		count = usageCount{}
+	}
+	count.Count += n
+	count.ExpiresAt = expiresAt
+	r.counts[key] = count
+	r.dirty = true
+
+	return count.Count, nil
+}
+
+// Run writes the counts to the file at Path periodically until stopCh is
+// closed. Flush must be called once the server stopped counting draws to
+// save the last ones.
+func (r *UsageRepo) Run(stopCh <-chan struct{}) {
+	ticker := time.NewTicker(r.config.FlushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+			if err := r.Flush(); err != nil {
+				log.GetLogger().Errorf("failed to save quota usage: %v", err)
+			}
+		}
+	}
+}
+
+// Flush drops the expired counts and writes the others to the file at Path,
+// replacing it at once so that a crash never leaves it half written.
+func (r *UsageRepo) Flush() error {
+	r.flushMu.Lock()
+	defer r.flushMu.Unlock()
+
+	r.mu.Lock()
+	r.evict()
+	if r.config.Path == "" || !r.dirty {
+		r.mu.Unlock()
+		return nil
+	}
+	data, err := json.Marshal(r.counts)
+	r.dirty = false
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomic(r.config.Path, data)
+	if err != nil {
+		r.mu.Lock()
+		r.dirty = true
+		r.mu.Unlock()
+	}
+
+	return err
+}
+
+// evict removes the expired counts, r.mu must be held.
+func (r *UsageRepo) evict() {
+	now := r.now()
+	for key, count := range r.counts {
+		if !now.Before(count.ExpiresAt) {
+			delete(r.counts, key)
+			r.dirty = true
+		}
+	}
+}
+
+// writeFileAtomic writes data to a temporary file next to path, then renames
+// it to path.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	file, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
@@ -0,0 +1,92 @@
+package random
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+func newTestUsageRepository(t *testing.T, path string, now *time.Time) *UsageRepo {
+	repo, err := NewUsageRepository(&config.Quotas{
+		Path:          path,
+		FlushInterval: time.Minute,
+	})
+	assert.NoError(t, err)
+	repo.now = func() time.Time {
+		return *now
+	}
+
+	return repo
+}
+
+func TestUsageRepo_Add(t *testing.T) {
+	now := time.Unix(0, 0)
+	repo := newTestUsageRepository(t, "", &now)
+	ctx := context.Background()
+
+	used, err := repo.Add(ctx, "a", 2, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), used)
+	used, err = repo.Add(ctx, "a", -1, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), used)
+
+	used, err = repo.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), used)
+	used, err = repo.Get(ctx, "b")
+	assert.NoError(t, err)
+	assert.Zero(t, used)
+
+	// Expired counts start over
+	now = now.Add(time.Hour)
+	used, err = repo.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.Zero(t, used)
+	used, err = repo.Add(ctx, "a", 1, now.Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), used)
+}
+
+func TestUsageRepo_Flush(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "quotas", "usage.json")
+	// Counts read back are checked against the real time before now applies
+	now := time.Now()
+	repo := newTestUsageRepository(t, path, &now)
+	ctx := context.Background()
+
+	_, err := repo.Add(ctx, "day", 3, now.Add(time.Hour))
+	assert.NoError(t, err)
+	_, err = repo.Add(ctx, "month", 5, now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.NoError(t, repo.Flush())
+
+	restarted := newTestUsageRepository(t, path, &now)
+	used, err := restarted.Get(ctx, "month")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), used, "Expected counts to survive restarts")
+
+	// Expired counts are dropped from the file
+	now = now.Add(time.Hour)
+	assert.NoError(t, restarted.Flush())
+	restarted = newTestUsageRepository(t, path, &now)
+	assert.Len(t, restarted.counts, 1)
+
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1, "Expected no temporary file to be left behind")
+}
+
+func TestNewUsageRepository_InvalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "usage.json")
+	assert.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+
+	_, err := NewUsageRepository(&config.Quotas{Path: path, FlushInterval: time.Minute})
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package random
+
+// aliasTable picks indices with a probability proportional to their weight
+// in constant time, using Vose's alias method.
+type aliasTable struct {
+	// prob is the probability of keeping column i rather than its alias
+	prob  []float64
+	alias []int64
+}
+
+// newAliasTable builds the table of weights, which must be finite,
+// non-negative and have a positive, finite sum.
+func newAliasTable(weights []float64) aliasTable {
+	n := len(weights)
+	sum := 0.0
+	for _, w := range weights {
+		sum += w
+	}
+
+	t := aliasTable{
+		prob:  make([]float64, n),
+		alias: make([]int64, n),
+	}
+
+	// Scale the weights so that they average 1, columns below 1 are
+	// topped up by a column above 1
+	scaled := make([]float64, n)
+	var small, large []int64
+	for i, w := range weights {
+		scaled[i] = w / sum * float64(n)
+		if scaled[i] < 1 {
+			small = append(small, int64(i))
+		} else {
+			large = append(large, int64(i))
+		}
+	}
+
+	for len(small) > 0 && len(large) > 0 {
+		l := small[len(small)-1]
+		small = small[:len(small)-1]
+		g := large[len(large)-1]
+		large = large[:len(large)-1]
+
+		t.prob[l] = scaled[l]
+		t.alias[l] = g
+		scaled[g] = (scaled[g] + scaled[l]) - 1
+		if scaled[g] < 1 {
+			small = append(small, g)
+		} else {
+			large = append(large, g)
+		}
+	}
+
+	// Whatever is left is 1 up to rounding errors
+	for _, i := range large {
+		t.prob[i] = 1
+	}
+	for _, i := range small {
+		t.prob[i] = 1
+	}
+
+	return t
+}
+
+// pick maps u, uniformly distributed in [0, 1), to an index. The integer
+// part of u*n selects the column and the fractional part decides between
+// the column and its alias.
+func (t aliasTable) pick(u float64) int64 {
+	x := u * float64(len(t.prob))
+	i := int64(x)
+	if i >= int64(len(t.prob)) {
+		i = int64(len(t.prob)) - 1
+	}
+
+	if x-float64(i) < t.prob[i] {
+		return i
+	}
+
+	return t.alias[i]
+}
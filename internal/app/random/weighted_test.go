@@ -0,0 +1,56 @@
+package random
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasTable_Frequencies(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+	}{
+		{name: "Uneven Weights", weights: []float64{1, 2, 0, 7}},
+		{name: "Single Item", weights: []float64{3}},
+		{name: "Equal Weights", weights: []float64{1, 1, 1, 1, 1}},
+		{name: "Tiny And Huge Weights", weights: []float64{1e-300, 1e300, 1e300}},
+	}
+
+	const draws = 200000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			table := newAliasTable(tt.weights)
+			rand := rand.New(rand.NewSource(42))
+
+			counts := make([]int, len(tt.weights))
+			for i := 0; i < draws; i++ {
+				counts[table.pick(rand.Float64())]++
+			}
+
+			sum := 0.0
+			for _, w := range tt.weights {
+				sum += w
+			}
+			for i, w := range tt.weights {
+				expected := w / sum
+				got := float64(counts[i]) / draws
+				if w == 0 {
+					assert.Zero(t, counts[i], "Expected items without weight to never be picked")
+					continue
+				}
+				// Five standard deviations of the binomial proportion
+				tolerance := 5 * math.Sqrt(expected*(1-expected)/draws)
+				assert.InDelta(t, expected, got, tolerance+1e-9, "Unexpected frequency of item %d", i)
+			}
+		})
+	}
+}
+
+func TestAliasTable_UpperBound(t *testing.T) {
+	table := newAliasTable([]float64{1, 1, 1})
+	index := table.pick(math.Nextafter(1, 0))
+	assert.Equal(t, int64(2), index, "Expected values just below 1 to land in the last column")
+}
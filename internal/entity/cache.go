@@ -0,0 +1,11 @@
+package entity
+
+import "context"
+
+// INumberCache keeps numbers that can be computed again, entries expire
+// after the TTL of the cache.
+type INumberCache interface {
+	// Get returns the number stored under key, ok is false when there is none.
+	Get(ctx context.Context, key string) (number int64, ok bool, err error)
+	Set(ctx context.Context, key string, number int64) error
+}
@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// Commitment binds the server to a secret before a provably fair draw.
+type Commitment struct {
+	ID string `json:"id"`
+	// Hash is the published commitment, the hex SHA-256 of Secret
+	Hash string `json:"hash"`
+	// Secret is only revealed by the draw
+	Secret    []byte    `json:"secret,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// FairDraw is the outcome of a provably fair draw, Commitment carries the
+// revealed secret so that the client can verify Number.
+type FairDraw struct {
+	Commitment Commitment `json:"commitment"`
+	ClientSeed string     `json:"client_seed"`
+	Range      IntRange   `json:"range"`
+	Number     int64      `json:"number"`
+}
+
+type ICommitmentRepository interface {
+	// Create stores commitment until it is taken or expires.
+	Create(ctx context.Context, commitment Commitment) error
+	// Take returns the commitment id and removes it, a commitment backs a
+	// single draw.
+	Take(ctx context.Context, id string) (Commitment, error)
+}
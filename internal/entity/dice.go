@@ -0,0 +1,40 @@
+package entity
+
+// Dice is one term of a dice expression, e.g. 4d6kh3 or 3d6!.
+type Dice struct {
+	Count int   `json:"count"`
+	Sides int64 `json:"sides"`
+	// Explode rolls one more die every time a die shows its highest face
+	Explode bool `json:"explode,omitempty"`
+	// Keep is the number of dice added to the total, 0 keeps every die
+	Keep int `json:"keep,omitempty"`
+	// KeepLowest keeps the lowest dice instead of the highest ones
+	KeepLowest bool `json:"keep_lowest,omitempty"`
+	// Negative subtracts the term from the total
+	Negative bool `json:"negative,omitempty"`
+}
+
+// DiceExpression is a parsed dice notation such as "4d6kh3+1d4-2".
+type DiceExpression struct {
+	Dice     []Dice `json:"dice"`
+	Modifier int64  `json:"modifier"`
+}
+
+// DieRoll is the result of a single die.
+type DieRoll struct {
+	// Term is the index of the die's term in the expression
+	Term  int   `json:"term"`
+	Sides int64 `json:"sides"`
+	Value int64 `json:"value"`
+	// Kept dice count towards the total
+	Kept bool `json:"kept"`
+	// Exploded dice were added because the previous die showed its highest face
+	Exploded bool `json:"exploded,omitempty"`
+}
+
+// Roll is the outcome of a dice expression.
+type Roll struct {
+	Total    int64     `json:"total"`
+	Modifier int64     `json:"modifier"`
+	Dice     []DieRoll `json:"dice"`
+}
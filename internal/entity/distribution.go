@@ -0,0 +1,36 @@
+package entity
+
+// DistributionKind names a statistical distribution.
+type DistributionKind string
+
+const (
+	Normal      DistributionKind = "normal"
+	Exponential DistributionKind = "exponential"
+	Poisson     DistributionKind = "poisson"
+	Binomial    DistributionKind = "binomial"
+	Uniform     DistributionKind = "uniform"
+)
+
+// Distribution is a statistical distribution with its parameters,
+// only the parameters used by Kind are set.
+type Distribution struct {
+	Kind DistributionKind `json:"kind"`
+
+	// Normal
+	Mean   float64 `json:"mean,omitempty"`
+	StdDev float64 `json:"std_dev,omitempty"`
+
+	// Exponential
+	Rate float64 `json:"rate,omitempty"`
+
+	// Poisson
+	Lambda float64 `json:"lambda,omitempty"`
+
+	// Binomial
+	N int64   `json:"n,omitempty"`
+	P float64 `json:"p,omitempty"`
+
+	// Uniform over [Min, Max)
+	Min float64 `json:"min,omitempty"`
+	Max float64 `json:"max,omitempty"`
+}
@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// Draw is a reply the server issued, or a message of a stream, kept in the
+// draw history.
+type Draw struct {
+	// Sequence numbers draws from 1 in the order they were saved
+	Sequence  uint64    `json:"sequence"`
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"request_id"`
+	ClientIP  string    `json:"client_ip"`
+	// Method is the gRPC method that produced the draw
+	Method string `json:"method"`
+	// Seed is 0 when secure or when the request has no single seed
+	Seed      int64     `json:"seed"`
+	Algorithm Algorithm `json:"algorithm"`
+	// Output is the reply as JSON
+	Output json.RawMessage `json:"output"`
+}
+
+// DrawFilter selects draws, zero fields select every draw.
+type DrawFilter struct {
+	// From and To bound the timestamp of draws to [From, To)
+	From      time.Time
+	To        time.Time
+	Seed      int64
+	ClientIP  string
+	RequestID string
+}
+
+// Match reports whether f selects draw.
+func (f DrawFilter) Match(draw Draw) bool {
+	return (f.From.IsZero() || !draw.Timestamp.Before(f.From)) &&
+		(f.To.IsZero() || draw.Timestamp.Before(f.To)) &&
+		(f.Seed == 0 || draw.Seed == f.Seed) &&
+		(f.ClientIP == "" || draw.ClientIP == f.ClientIP) &&
+		(f.RequestID == "" || draw.RequestID == f.RequestID)
+}
+
+// DrawPage is a page of draws, NextCursor is empty on the last page.
+type DrawPage struct {
+	Draws      []Draw
+	NextCursor string
+}
+
+type IDrawRepository interface {
+	// Save records draw, its sequence is set by the repository. Saved draws
+	// may take a moment to be listed.
+	Save(ctx context.Context, draw Draw) error
+	// List returns at most limit draws selected by filter, oldest first,
+	// starting after the page cursor is the NextCursor of, or from the first
+	// draw when cursor is empty.
+	List(ctx context.Context, filter DrawFilter, cursor string, limit int) (DrawPage, error)
+}
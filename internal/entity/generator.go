@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// Generator is a seeded generator kept on the server between calls.
+type Generator struct {
+	ID        string    `json:"id"`
+	Seed      int64     `json:"seed"`
+	Algorithm Algorithm `json:"algorithm"`
+	// IdleTimeout is how long the generator lives without being used
+	IdleTimeout time.Duration `json:"idle_timeout"`
+}
+
+type IGeneratorRepository interface {
+	// Create starts a generator for the sequence of seed with algorithm,
+	// an empty algorithm is the server's default.
+	Create(ctx context.Context, seed int64, algorithm string) (Generator, error)
+	// Next returns the next count values of the generator id, Index of the
+	// sequence is the number of values generated before them.
+	Next(ctx context.Context, id string, count int) (Sequence, error)
+	// Close frees the generator id.
+	Close(ctx context.Context, id string) error
+}
+
+// IDrawSession draws values from generator state owned by a single caller,
+// such as one stream, it is not safe for concurrent use. Index of the
+// sequences it returns counts the values drawn or skipped since the last reseed.
+type IDrawSession interface {
+	// Reseed restarts the session from seed with algorithm, an empty
+	// algorithm is the server's default.
+	Reseed(seed int64, algorithm string) (Algorithm, error)
+	// Next draws count values.
+	Next(count int) (Sequence, error)
+	// NextInRange draws count values in r.
+	NextInRange(r IntRange, count int) (Sequence, error)
+	// Skip discards the next n values, Index of the empty sequence is the
+	// position after them.
+	Skip(n int64) (Sequence, error)
+}
@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// IdempotentCall is a call made with an idempotency key, kept so that
+// retries with the key get its reply instead of being served again.
+type IdempotentCall struct {
+	Key string `json:"key"`
+	// Fingerprint identifies the method and the parameters of the call
+	Fingerprint string `json:"fingerprint"`
+	// Reply is empty while the call is in progress
+	Reply     []byte    `json:"reply,omitempty"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Done reports whether the call has a reply.
+func (c IdempotentCall) Done() bool {
+	return len(c.Reply) > 0
+}
+
+type IIdempotencyRepository interface {
+	// Reserve claims key for a call with fingerprint. When key is already
+	// claimed and not expired it returns the call that claimed it and false.
+	Reserve(ctx context.Context, key string, fingerprint string) (IdempotentCall, bool, error)
+	// Complete stores the reply of the call that claimed key.
+	Complete(ctx context.Context, key string, reply []byte) error
+	// Release frees key, for calls that failed and may be retried.
+	Release(ctx context.Context, key string) error
+}
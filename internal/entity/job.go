@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// JobKind is the estimate a Monte Carlo job computes.
+type JobKind string
+
+const (
+	// PiJob estimates pi from the share of random points of the unit square
+	// that fall within the quarter disc.
+	PiJob JobKind = "pi"
+	// IntegralJob estimates the integral of an expression of x over a range.
+	IntegralJob JobKind = "integral"
+)
+
+// JobState is the lifecycle of a job: queued, running, then one of the
+// finished states.
+type JobState string
+
+const (
+	JobQueued    JobState = "queued"
+	JobRunning   JobState = "running"
+	JobSucceeded JobState = "succeeded"
+	JobFailed    JobState = "failed"
+	JobCancelled JobState = "cancelled"
+)
+
+// Finished reports whether jobs in state s are done for good.
+func (s JobState) Finished() bool {
+	return s == JobSucceeded || s == JobFailed || s == JobCancelled
+}
+
+// JobSpec describes a Monte Carlo job.
+type JobSpec struct {
+	Kind JobKind `json:"kind"`
+	Seed int64   `json:"seed"`
+	// Algorithm is the seeded generator, empty selects the server's default
+	Algorithm string `json:"algorithm"`
+	// Samples is the number of points drawn
+	Samples int64 `json:"samples"`
+	// Expression is the integrand of integral jobs, a function of x
+	Expression string `json:"expression,omitempty"`
+	// Range is the interval integral jobs integrate over
+	Range FloatRange `json:"range"`
+}
+
+// JobResult is the outcome of a successful job.
+type JobResult struct {
+	Estimate float64 `json:"estimate"`
+	// StdError is the standard error of Estimate
+	StdError float64 `json:"std_error"`
+}
+
+// Job is a Monte Carlo job and its progress.
+type Job struct {
+	ID        string    `json:"id"`
+	Spec      JobSpec   `json:"spec"`
+	Algorithm Algorithm `json:"algorithm"`
+	State     JobState  `json:"state"`
+	// Progress is the number of samples drawn so far
+	Progress int64     `json:"progress"`
+	Result   JobResult `json:"result"`
+	// Error explains why a job failed or was cancelled
+	Error      string    `json:"error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+}
+
+type IJobRepository interface {
+	// Submit queues a job for spec, which must be valid.
+	Submit(ctx context.Context, spec JobSpec) (Job, error)
+	// Get returns the job id.
+	Get(ctx context.Context, id string) (Job, error)
+	// Cancel stops the job id, cancelling a finished job returns it unchanged.
+	Cancel(ctx context.Context, id string) (Job, error)
+	// List returns the jobs in state, or every job when state is empty,
+	// newest first.
+	List(ctx context.Context, state JobState) ([]Job, error)
+}
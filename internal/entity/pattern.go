@@ -0,0 +1,23 @@
+package entity
+
+// PatternPart is a run of characters of a string pattern, e.g. [A-Z]{3}.
+type PatternPart struct {
+	// Charset holds the characters the part picks from, without duplicates
+	Charset string `json:"charset"`
+	// Min and Max bound the number of characters, both inclusive
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
+// Pattern is a parsed string pattern such as "[A-Z]{3}-[0-9]{4}".
+type Pattern struct {
+	Parts []PatternPart `json:"parts"`
+}
+
+// StringSpec describes random strings, either by Pattern or by a Charset
+// such as "A-Z0-9" and a Length.
+type StringSpec struct {
+	Pattern string `json:"pattern,omitempty"`
+	Charset string `json:"charset,omitempty"`
+	Length  int    `json:"length,omitempty"`
+}
@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"context"
+	"time"
+)
+
+// QuotaWindow is the period over which a quota counts draws, windows start
+// at midnight UTC.
+type QuotaWindow string
+
+const (
+	Daily   QuotaWindow = "daily"
+	Monthly QuotaWindow = "monthly"
+)
+
+// QuotaUsage is the draws a client made in the current quota window.
+type QuotaUsage struct {
+	Window QuotaWindow
+	Used   int64
+	// Limit is 0 when the client has no quota over the window
+	Limit    int64
+	ResetsAt time.Time
+}
+
+// Usage is the consumption of a client over every quota window.
+type Usage struct {
+	// Client is the name of the client in the config, or its IP
+	Client string
+	Tier   string
+	Quotas []QuotaUsage
+}
+
+type IUsageRepository interface {
+	// Get returns the count stored under key, 0 when there is none.
+	Get(ctx context.Context, key string) (int64, error)
+	// Add adds n, which may be negative, to the count stored under key and
+	// returns the new count. The count is dropped after expiresAt.
+	Add(ctx context.Context, key string, n int64, expiresAt time.Time) (int64, error)
+}
@@ -1,16 +1,155 @@
 package entity
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 type Random struct {
-	Number int64 `json:"number"`
+	Number int64   `json:"number"`
+	Float  float64 `json:"float,omitempty"`
+	Mode   Mode    `json:"mode,omitempty"`
+	// Signature is set when the server signs its outputs
+	Signature *Signature `json:"signature,omitempty"`
+}
+
+// Signature is the Ed25519 signature of a random output by the server key KeyID.
+type Signature struct {
+	KeyID     string    `json:"key_id"`
+	Timestamp time.Time `json:"timestamp"`
+	Value     []byte    `json:"value"`
+}
+
+// PublicKey is a public key outputs are signed with.
+type PublicKey struct {
+	KeyID string `json:"key_id"`
+	Key   []byte `json:"key"`
+	// Active keys sign new outputs, the others only verify older ones
+	Active bool `json:"active"`
+}
+
+// Mode is how a random value is generated.
+type Mode string
+
+const (
+	// Seeded values are reproducible from their seed.
+	Seeded Mode = "seeded"
+	// Secure values come from crypto/rand and ignore the seed.
+	Secure Mode = "secure"
+)
+
+// Algorithm identifies the generator behind seeded values. Version changes
+// whenever the output for a given seed changes.
+type Algorithm struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// IntRange is the closed interval [Min, Max].
+type IntRange struct {
+	Min int64
+	Max int64
+}
+
+// FloatRange is the half-open interval [Min, Max).
+type FloatRange struct {
+	Min float64
+	Max float64
+}
+
+// Permutation is a seeded selection of positions in a population.
+type Permutation struct {
+	Indices []int64 `json:"indices"`
+	// ShuffleAlgorithm identifies how Indices were drawn, e.g. "fisher-yates/v1"
+	ShuffleAlgorithm string `json:"shuffle_algorithm"`
+}
+
+// Sequence is a slice of the sequence generated by a seed.
+type Sequence struct {
+	Numbers []int64 `json:"numbers"`
+	// Index is the position of Numbers[0] in the sequence
+	Index int64 `json:"index"`
+}
+
+// RandomResult is the outcome of a single item of a batch.
+type RandomResult struct {
+	Seed   int64
+	Random Random
+	Err    error
 }
 
 //go:generate mockery --name IRandomRepository --output ../mocks/ --case underscore
 type IRandomRepository interface {
+	// Algorithm returns the generator the repository is built on.
+	Algorithm() Algorithm
 	Get(ctx context.Context, seed int64) (Random, error)
+	// Stream calls send with successive values of the sequence generated by seed,
+	// stopping after count values, or when ctx is done if count is 0.
+	Stream(ctx context.Context, seed int64, count int64, send func(Random) error) error
+	// GetBatch returns one result per seed, in the same order as seeds.
+	GetBatch(ctx context.Context, seeds []int64) ([]RandomResult, error)
+	// GetInIntRange returns a Number uniformly distributed in r.
+	GetInIntRange(ctx context.Context, seed int64, r IntRange) (Random, error)
+	// GetInFloatRange returns a Float uniformly distributed in r.
+	GetInFloatRange(ctx context.Context, seed int64, r FloatRange) (Random, error)
+	// Sample returns count successive draws from dist using the sequence generated by seed.
+	Sample(ctx context.Context, seed int64, dist Distribution, count int64) ([]float64, error)
+	// Read returns n random bytes from the sequence generated by seed.
+	Read(ctx context.Context, seed int64, n int) ([]byte, error)
+	// Shuffle returns k positions of [0, n) drawn without replacement,
+	// the sample of k positions is the prefix of the permutation of n positions.
+	Shuffle(ctx context.Context, seed int64, n int64, k int64) (Permutation, error)
+	// Roll rolls the dice of expr using the sequence generated by seed.
+	Roll(ctx context.Context, seed int64, expr DiceExpression) (Roll, error)
+	// Strings returns count strings matching pattern using the sequence generated by seed.
+	Strings(ctx context.Context, seed int64, pattern Pattern, count int) ([]string, error)
+	// Records calls send with count records of schema generated by seed, the
+	// first records are the same whatever the count.
+	Records(ctx context.Context, seed int64, schema []FieldSpec, count int64, send func(Record) error) error
+	// GetAt returns count values of the sequence generated by seed, starting at
+	// position index, without generating the values before it. It fails when
+	// the algorithm cannot jump ahead.
+	GetAt(ctx context.Context, seed int64, index int64, count int) (Sequence, error)
 }
 
 type IRandomService interface {
-	Get(ctx context.Context, seed int64) (*Random, error)
+	// Algorithm returns the generator serving mode and the algorithm name,
+	// an empty name is the server's default algorithm.
+	Algorithm(mode Mode, name string) (Algorithm, error)
+	Get(ctx context.Context, seed int64, mode Mode, algorithm string) (*Random, error)
+	// Choose returns count indices of weights, each picked with a probability
+	// proportional to its weight.
+	Choose(ctx context.Context, seed int64, algorithm string, weights []float64, count int) ([]int64, error)
+	// Roll parses and rolls dice notation, e.g. "4d6kh3+2".
+	Roll(ctx context.Context, seed int64, algorithm string, notation string) (*Roll, error)
+	Stream(ctx context.Context, seed int64, algorithm string, count int64, send func(Random) error) error
+	GetBatch(ctx context.Context, seeds []int64, algorithm string) ([]RandomResult, error)
+	GetInIntRange(ctx context.Context, seed int64, algorithm string, r IntRange) (*Random, error)
+	GetInFloatRange(ctx context.Context, seed int64, algorithm string, r FloatRange) (*Random, error)
+	Sample(ctx context.Context, seed int64, algorithm string, dist Distribution, count int64) ([]float64, error)
+	GetBytes(ctx context.Context, seed int64, mode Mode, algorithm string, n int) ([]byte, error)
+	GetUUIDs(ctx context.Context, seed int64, mode Mode, algorithm string, version int, count int) ([]string, error)
+	GetToken(ctx context.Context, seed int64, mode Mode, algorithm string, length int) (string, error)
+	GenerateRecords(ctx context.Context, seed int64, algorithm string, schema []FieldSpec, count int64, send func(Record) error) error
+	GetStrings(ctx context.Context, seed int64, mode Mode, algorithm string, spec StringSpec, count int) ([]string, error)
+	Shuffle(ctx context.Context, seed int64, algorithm string, n int64, k int64) (*Permutation, error)
+	GetAt(ctx context.Context, seed int64, algorithm string, index int64, count int) (*Sequence, error)
+	CreateGenerator(ctx context.Context, seed int64, algorithm string) (*Generator, error)
+	Next(ctx context.Context, id string, count int) (*Sequence, error)
+	CloseGenerator(ctx context.Context, id string) error
+	NewDrawSession() IDrawSession
+	SubmitJob(ctx context.Context, spec JobSpec) (*Job, error)
+	GetJob(ctx context.Context, id string) (*Job, error)
+	CancelJob(ctx context.Context, id string) (*Job, error)
+	ListJobs(ctx context.Context, state JobState) ([]Job, error)
+	// ListDraws returns at most limit past draws selected by filter, oldest
+	// first, from the page cursor points to.
+	ListDraws(ctx context.Context, filter DrawFilter, cursor string, limit int) (*DrawPage, error)
+	// ExportDraws calls send with every past draw selected by filter, oldest first.
+	ExportDraws(ctx context.Context, filter DrawFilter, send func(Draw) error) error
+	// GetUsage returns the quota usage of the client calling with ctx.
+	GetUsage(ctx context.Context) (*Usage, error)
+	CreateCommitment(ctx context.Context) (*Commitment, error)
+	Draw(ctx context.Context, id string, clientSeed string, r IntRange) (*FairDraw, error)
+	GetPublicKey(ctx context.Context, keyID string) (*PublicKey, error)
 }
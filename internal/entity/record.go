@@ -0,0 +1,56 @@
+package entity
+
+import "time"
+
+// FieldKind is the type of the values of a record field.
+type FieldKind string
+
+const (
+	// PersonName fields hold a first and a last name.
+	PersonName FieldKind = "name"
+	// Email fields hold an address derived from a name.
+	Email FieldKind = "email"
+	// IntField fields hold an integer in IntRange.
+	IntField FieldKind = "int"
+	// DateField fields hold a day in DateRange.
+	DateField FieldKind = "date"
+	// EnumField fields hold one of Values.
+	EnumField FieldKind = "enum"
+	// UUIDField fields hold a version 4 UUID.
+	UUIDField FieldKind = "uuid"
+)
+
+// FieldSpec describes a field of generated records.
+type FieldSpec struct {
+	Name string    `json:"name"`
+	Kind FieldKind `json:"kind"`
+	// IntRange bounds int fields
+	IntRange IntRange `json:"int_range,omitempty"`
+	// DateRange bounds date fields
+	DateRange DateRange `json:"date_range,omitempty"`
+	// Values are the choices of enum fields
+	Values []string `json:"values,omitempty"`
+	// Domain is the domain of email fields, empty picks an example domain
+	Domain string `json:"domain,omitempty"`
+}
+
+// DateRange is the closed interval of days [Min, Max].
+type DateRange struct {
+	Min time.Time `json:"min"`
+	Max time.Time `json:"max"`
+}
+
+// Record is a generated record, Fields are in the order of the schema.
+type Record struct {
+	Index  int64        `json:"index"`
+	Fields []FieldValue `json:"fields"`
+}
+
+// FieldValue is the value of a record field, Int holds the value of int
+// fields and String the value of the others.
+type FieldValue struct {
+	Name   string    `json:"name"`
+	Kind   FieldKind `json:"kind"`
+	String string    `json:"string,omitempty"`
+	Int    int64     `json:"int,omitempty"`
+}
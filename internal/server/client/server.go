@@ -2,21 +2,32 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
 	"github.com/minhthong582000/soa-404/internal/app/client"
 	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
 	"github.com/minhthong582000/soa-404/pkg/log"
 	"github.com/minhthong582000/soa-404/pkg/metric"
 	http_middleware "github.com/minhthong582000/soa-404/pkg/middleware"
@@ -43,7 +54,6 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 	if err != nil {
 		return fmt.Errorf("error initializing logger: %v", err)
 	}
-	httpMiddleware := http_middleware.NewMiddleware()
 
 	// Tracing
 	_, err = tracing.TracerFactory(
@@ -88,47 +98,10 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 	}
 	defer conn.Close()
 
-	randClient := pb.NewRandomServiceClient(conn)
-	client := client.NewClient(randClient)
-
-	router := echo.New()
-	router.Use(middleware.RequestID())
-	router.Use(httpMiddleware.Logger())
-	router.Use(httpMiddleware.Metrics())
-	router.GET("/healthz", func(c echo.Context) error {
-		return c.String(200, "OK")
-	})
-	router.GET("/random", func(c echo.Context) error {
-		seedStr := c.QueryParam("seed")
-
-		// Check if seed is empty
-		if seedStr == "" {
-			return c.String(400, "seed is required")
-		}
-
-		// Convert seed to int64
-		seed, err := strconv.ParseInt(seedStr, 10, 64)
-		if err != nil {
-			return c.String(400, "seed must be an integer")
-		}
-
-		// Extract Client IP
-		clientIP := c.RealIP()
-
-		// Add client IP to gRPC metadata
-		ctx := metadata.AppendToOutgoingContext(c.Request().Context(), "x-client-ip", clientIP)
-
-		// Call the server
-		randNum, err := client.GetRandNumber(ctx, seed)
-		if err != nil {
-			return c.String(500, "failed to get random number")
-		}
-
-		// Return the random number in JSON
-		return c.JSON(200, map[string]int64{
-			"number": randNum,
-		})
-	})
+	router, err := newRouter(pb.NewRandomServiceClient(conn), &s.config.Client)
+	if err != nil {
+		return err
+	}
 
 	errCh := make(chan error, 1)
 	defer func() {
@@ -162,3 +135,272 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 
 	return nil
 }
+
+// newRouter returns the HTTP routes of the client, calling the server with
+// randClient.
+func newRouter(randClient pb.RandomServiceClient, config *config.Client) (*echo.Echo, error) {
+	client := client.NewClient(randClient)
+	httpMiddleware := http_middleware.NewMiddleware()
+
+	router := echo.New()
+	var err error
+	// The client IP identifies clients for quotas, it must not come from headers they can set
+	router.IPExtractor, err = ipExtractor(config.TrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	router.Use(middleware.RequestID())
+	router.Use(httpMiddleware.Logger())
+	// The gateway records its requests under the path template of the RPC they call
+	router.Use(httpMiddleware.Metrics(gatewayRoute, randomRoute))
+	router.GET("/healthz", func(c echo.Context) error {
+		return c.String(200, "OK")
+	})
+
+	// Every RPC but DrawSession and ExportDraws is served by the REST/JSON
+	// gateway generated from the google.api.http annotations of random.proto
+	gateway := runtime.NewServeMux(append(httpMiddleware.GatewayMetrics(),
+		// Metadata comes from outgoingContext only, clients cannot set it with Grpc-Metadata headers
+		runtime.WithIncomingHeaderMatcher(func(string) (string, bool) {
+			return "", false
+		}),
+		runtime.WithMetadata(gatewayMetadata),
+		runtime.WithErrorHandler(gatewayError),
+	)...)
+	if err := pb.RegisterRandomServiceHandlerClient(context.Background(), gateway, randClient); err != nil {
+		return nil, fmt.Errorf("error registering gateway: %v", err)
+	}
+	router.Any(gatewayRoute, func(c echo.Context) error {
+		gateway.ServeHTTP(c.Response(), c.Request().WithContext(outgoingContext(c)))
+		return nil
+	})
+
+	// The gateway would wrap every chunk of the file in JSON
+	router.GET("/v1/draws/export", func(c echo.Context) error {
+		from, to, err := timeWindow(c)
+		if err != nil {
+			return c.String(400, err.Error())
+		}
+		format, err := exportFormat(c)
+		if err != nil {
+			return c.String(400, err.Error())
+		}
+		contentType, filename := "text/csv; charset=utf-8", "draws.csv"
+		if format == pb.ExportFormat_EXPORT_FORMAT_NDJSON {
+			contentType, filename = "application/x-ndjson", "draws.ndjson"
+		}
+
+		// Chunks are written as they arrive, the status is sent with the first one
+		response := c.Response()
+		err = client.ExportDraws(outgoingContext(c), from, to, format, func(chunk []byte) error {
+			if !response.Committed {
+				response.Header().Set(echo.HeaderContentType, contentType)
+				response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+				response.WriteHeader(200)
+			}
+			if _, err := response.Write(chunk); err != nil {
+				return err
+			}
+			response.Flush()
+
+			return nil
+		})
+		if err != nil {
+			if response.Committed {
+				// The download is cut short, there is no way to report it past the status
+				log.GetLogger().Errorf("Failed to export draws: %v", err)
+				return nil
+			}
+			st := status.Convert(err)
+			return c.String(grpc_errors.MapGRPCErrCodeToHttpStatus(st.Code()), st.Message())
+		}
+
+		return nil
+	})
+
+	// The route of the client before the gateway, with its query parameters
+	router.GET(randomRoute, func(c echo.Context) error {
+		request := c.Request().WithContext(outgoingContext(c))
+		path, query := randomQuery(c.QueryParams())
+		request.URL = &url.URL{Path: path, RawQuery: query.Encode()}
+		gateway.ServeHTTP(c.Response(), request)
+		return nil
+	})
+
+	return router, nil
+}
+
+const (
+	// gatewayRoute is the route of the REST/JSON gateway.
+	gatewayRoute = "/v1/*"
+	// randomRoute is the route of the client before the gateway, an alias of
+	// /v1/random and /v1/random/range.
+	randomRoute = "/random"
+)
+
+// ipExtractor returns how to find the IP of clients: the address they connect
+// from, or the X-Forwarded-For header when they connect through the trusted
+// proxies.
+func ipExtractor(trustedProxies []string) (echo.IPExtractor, error) {
+	if len(trustedProxies) == 0 {
+		return echo.ExtractIPDirect(), nil
+	}
+
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, cidr := range trustedProxies {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %v", cidr, err)
+		}
+		options = append(options, echo.TrustIPRange(ipNet))
+	}
+
+	return echo.ExtractIPFromXFFHeader(options...), nil
+}
+
+// gatewayMetadata passes the metadata outgoingContext added to the request
+// context on to the server.
+func gatewayMetadata(ctx context.Context, _ *http.Request) metadata.MD {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return md
+}
+
+// gatewayError writes the gRPC status of failed gateway calls as JSON. It
+// hides the reason of server errors, and tells clients over quota when to
+// retry.
+func gatewayError(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	retryAfter(w.Header(), st)
+	if code := runtime.HTTPStatusFromCode(st.Code()); code >= 500 {
+		err = status.Error(st.Code(), http.StatusText(code))
+	}
+
+	runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+}
+
+// retryAfter sets the Retry-After header of responses to calls the server
+// asked to retry later, such as draws over quota.
+func retryAfter(header http.Header, st *status.Status) {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			seconds := int64(math.Ceil(info.RetryDelay.AsDuration().Seconds()))
+			header.Set(echo.HeaderRetryAfter, strconv.FormatInt(seconds, 10))
+			return
+		}
+	}
+}
+
+const (
+	// idempotencyKeyHeader is the HTTP header of idempotency keys.
+	idempotencyKeyHeader = "Idempotency-Key"
+	// apiKeyHeader is the HTTP header of API keys, which identify clients for quotas.
+	apiKeyHeader = "X-API-Key"
+)
+
+// outgoingContext passes the client IP and the request ID to the server, which logs and journals them,
+// along with the idempotency key and the API key of the request if any.
+func outgoingContext(c echo.Context) context.Context {
+	ctx := metadata.AppendToOutgoingContext(c.Request().Context(),
+		grpcUtils.ClientIPHeader, c.RealIP(),
+		grpcUtils.RequestIDHeader, c.Response().Header().Get(echo.HeaderXRequestID),
+	)
+	if key := c.Request().Header.Get(idempotencyKeyHeader); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcUtils.IdempotencyKeyHeader, key)
+	}
+	if key := c.Request().Header.Get(apiKeyHeader); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, grpcUtils.APIKeyHeader, key)
+	}
+
+	return ctx
+}
+
+// randomQuery maps the query parameters of /random onto the gateway route
+// answering them: /v1/random/range when min or max is set, with integer
+// bounds unless either of them is a float, /v1/random otherwise or in secure
+// mode. The server validates them like those of any gateway call.
+func randomQuery(params url.Values) (string, url.Values) {
+	query := url.Values{}
+	for name, field := range map[string]string{"seed": "SeedNum", "algorithm": "Algorithm"} {
+		if value := params.Get(name); value != "" {
+			query.Set(field, value)
+		}
+	}
+
+	mode := params.Get("mode")
+	switch mode {
+	case "":
+	case "seeded", "secure":
+		query.Set("Mode", "MODE_"+strings.ToUpper(mode))
+	default:
+		query.Set("Mode", mode)
+	}
+
+	min, max := params.Get("min"), params.Get("max")
+	if mode == "secure" || (min == "" && max == "") {
+		return "/v1/random", query
+	}
+
+	bounds := "IntRange"
+	for _, bound := range []string{min, max} {
+		if _, err := strconv.ParseInt(bound, 10, 64); bound != "" && err != nil {
+			bounds = "FloatRange"
+		}
+	}
+	if min != "" {
+		query.Set(bounds+".Min", min)
+	}
+	if max != "" {
+		query.Set(bounds+".Max", max)
+	}
+
+	return "/v1/random/range", query
+}
+
+// timeWindow reads the optional `from` and `to` RFC 3339 time query
+// parameters, zero when unset.
+func timeWindow(c echo.Context) (time.Time, time.Time, error) {
+	var window [2]time.Time
+	for i, name := range []string{"from", "to"} {
+		str := c.QueryParam(name)
+		if str == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%s must be an RFC 3339 time", name)
+		}
+		window[i] = t
+	}
+
+	return window[0], window[1], nil
+}
+
+// exportFormat picks the format of a draw export from the `format` query
+// parameter, then the Accept header, CSV by default.
+func exportFormat(c echo.Context) (pb.ExportFormat, error) {
+	switch c.QueryParam("format") {
+	case "csv":
+		return pb.ExportFormat_EXPORT_FORMAT_CSV, nil
+	case "ndjson":
+		return pb.ExportFormat_EXPORT_FORMAT_NDJSON, nil
+	case "":
+	default:
+		return pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED, errors.New("format must be csv or ndjson")
+	}
+
+	for _, accepted := range strings.Split(c.Request().Header.Get(echo.HeaderAccept), ",") {
+		mediaType, _, _ := strings.Cut(accepted, ";")
+		switch strings.TrimSpace(mediaType) {
+		case "text/csv":
+			return pb.ExportFormat_EXPORT_FORMAT_CSV, nil
+		case "application/x-ndjson", "application/ndjson":
+			return pb.ExportFormat_EXPORT_FORMAT_NDJSON, nil
+		}
+	}
+
+	return pb.ExportFormat_EXPORT_FORMAT_CSV, nil
+}
@@ -0,0 +1,267 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+	"github.com/minhthong582000/soa-404/pkg/metric"
+)
+
+// fakeRandClient answers GetRandNumber and GetRandNumberInRange with the
+// seed, and records the calls.
+type fakeRandClient struct {
+	pb.RandomServiceClient
+	requests []proto.Message
+	md       metadata.MD
+}
+
+func (f *fakeRandClient) GetRandNumber(ctx context.Context, in *pb.GetRandNumberRequest, _ ...grpc.CallOption) (*pb.GetRandNumberReply, error) {
+	f.requests = append(f.requests, in)
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+	if in.SeedNum == 1 {
+		return nil, status.Error(codes.InvalidArgument, "SeedNum must be greater than or equal to 3")
+	}
+
+	return &pb.GetRandNumberReply{Number: in.SeedNum, Mode: in.Mode}, nil
+}
+
+func (f *fakeRandClient) GetRandNumberInRange(ctx context.Context, in *pb.GetRandNumberInRangeRequest, _ ...grpc.CallOption) (*pb.GetRandNumberInRangeReply, error) {
+	f.requests = append(f.requests, in)
+	f.md, _ = metadata.FromOutgoingContext(ctx)
+
+	return &pb.GetRandNumberInRangeReply{Value: &pb.GetRandNumberInRangeReply_Number{Number: in.SeedNum}}, nil
+}
+
+func newTestRouter(t *testing.T, trustedProxies ...string) (http.Handler, *fakeRandClient) {
+	randClient := &fakeRandClient{}
+	router, err := newRouter(randClient, &config.Client{TrustedProxies: trustedProxies})
+	assert.NoError(t, err)
+
+	return router, randClient
+}
+
+func get(handler http.Handler, target string, header ...string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodGet, target, nil)
+	for i := 0; i+1 < len(header); i += 2 {
+		request.Header.Set(header[i], header[i+1])
+	}
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	return recorder
+}
+
+func TestRouter_Random(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		code     int
+		number   string
+		message  string
+		expected proto.Message
+	}{
+		{
+			name:     "Seed",
+			target:   "/random?seed=123",
+			code:     200,
+			number:   "123",
+			expected: &pb.GetRandNumberRequest{SeedNum: 123},
+		},
+		{
+			name:     "Secure",
+			target:   "/random?mode=secure&min=1&max=6",
+			code:     200,
+			number:   "0",
+			expected: &pb.GetRandNumberRequest{Mode: pb.Mode_MODE_SECURE},
+		},
+		{
+			name:     "Algorithm",
+			target:   "/random?seed=123&mode=seeded&algorithm=pcg",
+			code:     200,
+			number:   "123",
+			expected: &pb.GetRandNumberRequest{SeedNum: 123, Mode: pb.Mode_MODE_SEEDED, Algorithm: "pcg"},
+		},
+		{
+			name:   "Range",
+			target: "/random?seed=123&min=1&max=6",
+			code:   200,
+			number: "123",
+			expected: &pb.GetRandNumberInRangeRequest{
+				SeedNum: 123,
+				Range:   &pb.GetRandNumberInRangeRequest_IntRange{IntRange: &pb.IntRange{Min: 1, Max: 6}},
+			},
+		},
+		{
+			name:   "Float range",
+			target: "/random?seed=123&min=0&max=1.5",
+			code:   200,
+			number: "123",
+			expected: &pb.GetRandNumberInRangeRequest{
+				SeedNum: 123,
+				Range:   &pb.GetRandNumberInRangeRequest_FloatRange{FloatRange: &pb.FloatRange{Min: 0, Max: 1.5}},
+			},
+		},
+		{
+			name:    "Invalid seed",
+			target:  "/random?seed=abc",
+			code:    400,
+			message: "SeedNum",
+		},
+		{
+			name:    "Invalid mode",
+			target:  "/random?seed=123&mode=fast",
+			code:    400,
+			message: "fast",
+		},
+		{
+			name:     "Invalid argument",
+			target:   "/random?seed=1",
+			code:     400,
+			message:  "SeedNum must be greater than or equal to 3",
+			expected: &pb.GetRandNumberRequest{SeedNum: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, randClient := newTestRouter(t)
+
+			// Replies and errors are those of the gateway
+			recorder := get(router, tt.target)
+			assert.Equal(t, tt.code, recorder.Code)
+			var reply map[string]any
+			assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &reply))
+			if tt.code == 200 {
+				assert.Equal(t, tt.number, reply["Number"])
+			} else {
+				assert.Contains(t, reply["message"], tt.message)
+			}
+			if tt.expected == nil {
+				assert.Empty(t, randClient.requests)
+			} else if assert.Len(t, randClient.requests, 1) {
+				assert.True(t, proto.Equal(tt.expected, randClient.requests[0]), "Expected %v, got %v", tt.expected, randClient.requests[0])
+			}
+		})
+	}
+}
+
+func TestRouter_Gateway(t *testing.T) {
+	router, randClient := newTestRouter(t)
+
+	recorder := get(router, "/v1/random?SeedNum=123&Mode=MODE_SECURE")
+	assert.Equal(t, 200, recorder.Code)
+	var reply map[string]any
+	assert.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &reply))
+	assert.Equal(t, "123", reply["Number"])
+	assert.Equal(t, "MODE_SECURE", reply["Mode"])
+	if assert.Len(t, randClient.requests, 1) {
+		assert.True(t, proto.Equal(&pb.GetRandNumberRequest{SeedNum: 123, Mode: pb.Mode_MODE_SECURE}, randClient.requests[0]))
+	}
+
+	recorder = get(router, "/v1/random?SeedNum=1")
+	assert.Equal(t, 400, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "SeedNum must be greater than or equal to 3")
+
+	// The hand-written routes the gateway replaced are gone
+	for _, target := range []string{"/bytes?seed=123", "/draws", "/usage"} {
+		assert.Equal(t, 404, get(router, target).Code, target)
+	}
+}
+
+// fakeMetrics records the labels of the request counter.
+type fakeMetrics struct {
+	metric.Metrics
+	requests [][]string
+}
+
+func (f *fakeMetrics) IsMetricExist(name string) bool {
+	return name == metric.Http_request_total.Name
+}
+
+func (f *fakeMetrics) Counter(_ *metric.Metric, _ float64, labelValues ...string) error {
+	f.requests = append(f.requests, labelValues)
+	return nil
+}
+
+func TestRouter_Metrics(t *testing.T) {
+	metrics := &fakeMetrics{}
+	previous := metric.GetMetric()
+	metric.SetMetric(metrics)
+	t.Cleanup(func() {
+		metric.SetMetric(previous)
+	})
+	router, _ := newTestRouter(t)
+
+	for _, target := range []string{
+		"/v1/random?SeedNum=123",
+		"/v1/random?SeedNum=1",
+		"/v1/random/range?SeedNum=123&IntRange.Min=1&IntRange.Max=6",
+		"/random?seed=123&min=1&max=6",
+		"/v1/unknown",
+		"/healthz",
+	} {
+		get(router, target)
+	}
+
+	// Gateway requests, /random included, are recorded under the path of the RPC they call
+	assert.Equal(t, [][]string{
+		{"/v1/random", "200"},
+		{"/v1/random", "400"},
+		{"/v1/random/range", "200"},
+		{"/v1/random/range", "200"},
+		{"", "404"},
+		{"/healthz", "200"},
+	}, metrics.requests)
+}
+
+func TestRouter_ClientIP(t *testing.T) {
+	tests := []struct {
+		name           string
+		trustedProxies []string
+		expected       string
+	}{
+		{
+			name:     "No trusted proxies",
+			expected: "192.0.2.1",
+		},
+		{
+			name:           "Trusted proxy",
+			trustedProxies: []string{"192.0.2.0/24"},
+			expected:       "203.0.113.9",
+		},
+		{
+			name:           "Untrusted proxy",
+			trustedProxies: []string{"198.51.100.0/24"},
+			expected:       "192.0.2.1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router, randClient := newTestRouter(t, tt.trustedProxies...)
+
+			// httptest requests come from 192.0.2.1
+			for _, target := range []string{"/random?seed=123", "/v1/random?SeedNum=123"} {
+				recorder := get(router, target, "X-Forwarded-For", "203.0.113.9", "Grpc-Metadata-X-Client-Ip", "203.0.113.10")
+				assert.Equal(t, 200, recorder.Code)
+				assert.Equal(t, []string{tt.expected}, randClient.md.Get(grpcUtils.ClientIPHeader), target)
+			}
+		})
+	}
+
+	_, err := newRouter(&fakeRandClient{}, &config.Client{TrustedProxies: []string{"192.0.2.1"}})
+	assert.Error(t, err)
+}
@@ -13,9 +13,11 @@ import (
 	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
 	"github.com/minhthong582000/soa-404/internal/app/random"
 	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/journal"
 	"github.com/minhthong582000/soa-404/pkg/log"
 	"github.com/minhthong582000/soa-404/pkg/metric"
 	"github.com/minhthong582000/soa-404/pkg/middleware"
+	"github.com/minhthong582000/soa-404/pkg/signing"
 	"github.com/minhthong582000/soa-404/pkg/tracing"
 )
 
@@ -54,6 +56,15 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 			metric.Grpc_server_msg_received_total,
 			metric.Grpc_server_msg_sent_total,
 			metric.Grpc_server_handling_seconds,
+			metric.Random_generator_sessions_active,
+			metric.Random_generator_sessions_evicted_total,
+			metric.Random_jobs_queued,
+			metric.Random_job_duration_seconds,
+			metric.Random_cache_hits_total,
+			metric.Random_cache_misses_total,
+			metric.Random_cache_fallbacks_total,
+			metric.Random_quota_draws_total,
+			metric.Random_quota_rejected_total,
 		),
 	)
 	if err != nil {
@@ -72,21 +83,101 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 		return fmt.Errorf("error initializing tracer: %v", err)
 	}
 
-	// Register logs & metrics & trace interceptor
+	registry, err := random.NewRegistry(&s.config.Random)
+	if err != nil {
+		return fmt.Errorf("error initializing random algorithms: %v", err)
+	}
+	if s.config.Random.Cache.Enabled {
+		registry.UseCache(random.NewCache(&s.config.Random.Cache))
+	}
+
+	// Draw journal
+	drawJournal, err := journal.Open(&s.config.Journal)
+	if err != nil {
+		return fmt.Errorf("error opening draw journal: %v", err)
+	}
+	go drawJournal.Run()
+	draws := random.NewDrawRepository(drawJournal)
+
+	// Register logs & metrics & trace & journal interceptor
 	in := middleware.NewInterceptor()
+	clientIP, err := middleware.NewClientIP(s.config.Server.TrustedGateways)
+	if err != nil {
+		return fmt.Errorf("error initializing client IPs: %v", err)
+	}
+	// The client IP is set first, everything after logs, journals and counts it
+	unaryInterceptors := []grpc.UnaryServerInterceptor{
+		clientIP.Unary,
+		in.Logger,
+		in.Metrics,
+		grpc_ctxtags.UnaryServerInterceptor(),
+		recovery.UnaryServerInterceptor(),
+		in.Errors,
+	}
+	streamInterceptors := []grpc.StreamServerInterceptor{
+		clientIP.Stream,
+		in.StreamLogger,
+		in.StreamMetrics,
+		grpc_ctxtags.StreamServerInterceptor(),
+		recovery.StreamServerInterceptor(),
+		in.StreamErrors,
+	}
+	idempotency := random.NewIdempotencyRepository(&s.config.Random.Idempotency)
+	go idempotency.Run(stopCh)
+	// Replayed draws skip the journal and the quotas, they were counted when first drawn
+	unaryInterceptors = append(unaryInterceptors, random.NewIdempotency(idempotency).Unary)
+	usage, err := random.NewUsageRepository(&s.config.Random.Quotas)
+	if err != nil {
+		return fmt.Errorf("error loading quota usage: %v", err)
+	}
+	quotas, err := random.NewQuotas(&s.config.Random.Quotas, usage)
+	if err != nil {
+		return fmt.Errorf("error initializing quotas: %v", err)
+	}
+	if s.config.Random.Quotas.Enabled {
+		go usage.Run(stopCh)
+		unaryInterceptors = append(unaryInterceptors, quotas.Unary)
+		streamInterceptors = append(streamInterceptors, quotas.Stream)
+	}
+	if drawJournal.Enabled() {
+		journalInterceptor := random.NewDrawJournal(draws, registry)
+		unaryInterceptors = append(unaryInterceptors, journalInterceptor.Unary)
+		streamInterceptors = append(streamInterceptors, journalInterceptor.Stream)
+	}
+	signer, err := signing.NewSigner(&s.config.Random.Signing)
+	if err != nil {
+		return fmt.Errorf("error loading signing keys: %v", err)
+	}
+	// Draws are signed before they are journaled, so that the history keeps their signatures
+	if signer.Enabled() {
+		replySigner := random.NewReplySigner(signer)
+		unaryInterceptors = append(unaryInterceptors, replySigner.Unary)
+		streamInterceptors = append(streamInterceptors, replySigner.Stream)
+	}
 	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			in.Logger,
-			in.Metrics,
-			grpc_ctxtags.UnaryServerInterceptor(),
-			recovery.UnaryServerInterceptor(),
-		),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	)
 
+	sessions := random.NewSessionRepository(registry, &s.config.Random.Sessions)
+	go sessions.Run(stopCh)
+	commitments := random.NewCommitmentRepository(&s.config.Random.Commitments)
+	go commitments.Run(stopCh)
+	jobs := random.NewJobRepository(registry, &s.config.Random.Jobs)
+	go jobs.Run(stopCh)
+
 	randomServer := random.NewServer(
 		random.NewService(
-			random.NewRepository(),
+			registry,
+			random.NewSecureRepository(),
+			sessions,
+			commitments,
+			jobs,
+			draws,
+			quotas,
+			signer,
+			&s.config.Random,
 		),
 	)
 	pb.RegisterRandomServiceServer(grpcServer, randomServer)
@@ -95,6 +186,12 @@ func (s Server) Run(stopCh <-chan struct{}) error {
 	defer func() {
 		logger.Infof("Shutting down gRPC server...")
 		grpcServer.GracefulStop()
+		if err := drawJournal.Close(); err != nil {
+			logger.Errorf("Failed to close draw journal: %v", err)
+		}
+		if err := usage.Flush(); err != nil {
+			logger.Errorf("Failed to save quota usage: %v", err)
+		}
 		close(errCh)
 		logger.Info("Bye!")
 	}()
@@ -2,6 +2,7 @@ package config
 
 import (
 	"errors"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/spf13/viper"
@@ -11,6 +12,9 @@ import (
 type Server struct {
 	BindAddr string `mapstructure:"bind_addr" validate:"required"`
 	Name     string `mapstructure:"name" validate:"required"`
+	// TrustedGateways are the CIDR ranges of the gateways allowed to forward
+	// the IP of their clients, the IP of other callers is their address
+	TrustedGateways []string `mapstructure:"trusted_gateways" validate:"dive,cidr"`
 }
 
 // Client config
@@ -18,6 +22,121 @@ type Client struct {
 	BindAddr   string `mapstructure:"bind_addr" validate:"required"`
 	ServerAddr string `mapstructure:"server_addr" validate:"required"`
 	Name       string `mapstructure:"name" validate:"required"`
+	// TrustedProxies are the CIDR ranges of the proxies whose X-Forwarded-For
+	// header is trusted, without any the IP of clients is their address
+	TrustedProxies []string `mapstructure:"trusted_proxies" validate:"dive,cidr"`
+}
+
+// Random service config
+type Random struct {
+	MaxBatchSize       int         `mapstructure:"max_batch_size" validate:"required,gte=1,lte=1000"`
+	DefaultAlgorithm   string      `mapstructure:"default_algorithm" validate:"required"`
+	DisabledAlgorithms []string    `mapstructure:"disabled_algorithms"`
+	Sessions           Sessions    `mapstructure:"sessions" validate:"required"`
+	Commitments        Commitments `mapstructure:"commitments" validate:"required"`
+	Signing            Signing     `mapstructure:"signing"`
+	Jobs               Jobs        `mapstructure:"jobs" validate:"required"`
+	Cache              Cache       `mapstructure:"cache" validate:"required"`
+	Idempotency        Idempotency `mapstructure:"idempotency" validate:"required"`
+	Quotas             Quotas      `mapstructure:"quotas" validate:"required"`
+}
+
+// Generator sessions config
+type Sessions struct {
+	TTL         time.Duration `mapstructure:"ttl" validate:"required,gt=0"`
+	MaxSessions int           `mapstructure:"max_sessions" validate:"required,gte=1"`
+}
+
+// Provably fair draw commitments config
+type Commitments struct {
+	TTL            time.Duration `mapstructure:"ttl" validate:"required,gt=0"`
+	MaxCommitments int           `mapstructure:"max_commitments" validate:"required,gte=1"`
+}
+
+// Monte Carlo jobs config
+type Jobs struct {
+	Workers    int           `mapstructure:"workers" validate:"required,gte=1"`
+	QueueSize  int           `mapstructure:"queue_size" validate:"required,gte=1"`
+	MaxJobs    int           `mapstructure:"max_jobs" validate:"required,gte=1"`
+	Retention  time.Duration `mapstructure:"retention" validate:"required,gt=0"`
+	MaxSamples int64         `mapstructure:"max_samples" validate:"required,gte=1"`
+}
+
+// Seeded number cache config, numbers are cached in memory only without a
+// Redis address, and while Redis fails
+type Cache struct {
+	Enabled       bool   `mapstructure:"enabled"`
+	RedisAddr     string `mapstructure:"redis_addr"`
+	RedisPassword string `mapstructure:"redis_password"`
+	RedisDB       int    `mapstructure:"redis_db" validate:"gte=0"`
+	// RedisTimeout bounds every Redis call before falling back to memory
+	RedisTimeout time.Duration `mapstructure:"redis_timeout" validate:"required,gt=0"`
+	TTL          time.Duration `mapstructure:"ttl" validate:"required,gt=0"`
+	// MaxEntries is the size of the in-memory cache
+	MaxEntries int `mapstructure:"max_entries" validate:"required,gte=1"`
+}
+
+// Idempotency keys config
+type Idempotency struct {
+	// TTL is how long retries with a key get the reply of its first call
+	TTL     time.Duration `mapstructure:"ttl" validate:"required,gt=0"`
+	MaxKeys int           `mapstructure:"max_keys" validate:"required,gte=1"`
+}
+
+// Draw quotas config, clients are identified by their API key, or by their
+// IP when they send none
+type Quotas struct {
+	Enabled bool `mapstructure:"enabled"`
+	// DefaultTier is the tier of clients not listed in Clients
+	DefaultTier string        `mapstructure:"default_tier" validate:"required_if=Enabled true"`
+	Tiers       []QuotaTier   `mapstructure:"tiers" validate:"dive"`
+	Clients     []QuotaClient `mapstructure:"clients" validate:"dive"`
+	// Path is where usage is kept across restarts, leave empty to keep it in memory only
+	Path string `mapstructure:"path"`
+	// FlushInterval is how often usage is written to Path
+	FlushInterval time.Duration `mapstructure:"flush_interval" validate:"required,gt=0"`
+	// MaxAnonymousClients bounds the IPs counted each month, further IPs
+	// are rejected until the next month
+	MaxAnonymousClients int `mapstructure:"max_anonymous_clients" validate:"required,gte=1"`
+}
+
+// Quota tier, a limit of 0 is no limit
+type QuotaTier struct {
+	Name    string `mapstructure:"name" validate:"required"`
+	Daily   int64  `mapstructure:"daily" validate:"gte=0"`
+	Monthly int64  `mapstructure:"monthly" validate:"gte=0"`
+}
+
+// Quota client, limits of 0 are those of its tier
+type QuotaClient struct {
+	Name     string `mapstructure:"name" validate:"required"`
+	APIKey   string `mapstructure:"api_key" validate:"required_without=ClientIP"`
+	ClientIP string `mapstructure:"client_ip" validate:"required_without=APIKey"`
+	// Tier defaults to the default tier
+	Tier    string `mapstructure:"tier"`
+	Daily   int64  `mapstructure:"daily" validate:"gte=0"`
+	Monthly int64  `mapstructure:"monthly" validate:"gte=0"`
+}
+
+// Output signing config, signing is disabled without an active key
+type Signing struct {
+	ActiveKeyID string       `mapstructure:"active_key_id"`
+	Keys        []SigningKey `mapstructure:"keys" validate:"dive"`
+}
+
+// Ed25519 signing key, retired keys only need their public key
+type SigningKey struct {
+	ID             string `mapstructure:"id" validate:"required,max=64"`
+	PrivateKeyFile string `mapstructure:"private_key_file" validate:"required_without=PublicKeyFile"`
+	PublicKeyFile  string `mapstructure:"public_key_file" validate:"required_without=PrivateKeyFile"`
+}
+
+// Draw journal config
+type Journal struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Path    string `mapstructure:"path" validate:"required_if=Enabled true"`
+	// BufferSize is the number of draws queued before the handlers wait for the disk
+	BufferSize int `mapstructure:"buffer_size" validate:"required,gte=1"`
 }
 
 // Logger config
@@ -61,6 +180,8 @@ type Tracing struct {
 type Config struct {
 	Server  Server  `mapstructure:"server" validate:"required"`
 	Client  Client  `mapstructure:"client" validate:"required"`
+	Random  Random  `mapstructure:"random" validate:"required"`
+	Journal Journal `mapstructure:"journal" validate:"required"`
 	Logs    Logs    `mapstructure:"logs" validate:"required"`
 	Metrics Metrics `mapstructure:"metrics" validate:"required"`
 	Tracing Tracing `mapstructure:"tracing" validate:"required"`
@@ -0,0 +1,51 @@
+// Package fair implements the commit-reveal scheme behind provably fair draws.
+//
+// The server publishes Commit(secret) before it sees the client seed, draws
+// Number(secret, clientSeed, min, max) once the client seed is known and then
+// reveals the secret. Anyone can recompute both values to check that the
+// result was fixed before the client seed was chosen and not altered after.
+package fair
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+)
+
+// Commit returns the commitment to secret, the hex SHA-256 of the secret.
+func Commit(secret []byte) string {
+	sum := sha256.Sum256(secret)
+	return hex.EncodeToString(sum[:])
+}
+
+// Number returns the number in [min, max] drawn from secret and clientSeed,
+// min must be less than or equal to max.
+//
+// Candidates are the first 8 bytes, big-endian, of
+// HMAC-SHA256(secret, clientSeed + ":" + counter) for counter = 0, 1, ...
+// Candidates below 2^64 mod width are skipped so that the rest reduce
+// modulo the width of the range without bias.
+func Number(secret []byte, clientSeed string, min, max int64) int64 {
+	// A width of 0 means the range covers every int64
+	width := uint64(max-min) + 1
+	var threshold uint64
+	if width != 0 {
+		threshold = -width % width
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	for counter := uint64(0); ; counter++ {
+		mac.Reset()
+		mac.Write([]byte(clientSeed + ":" + strconv.FormatUint(counter, 10)))
+		v := binary.BigEndian.Uint64(mac.Sum(nil))
+
+		if width == 0 {
+			return int64(v)
+		}
+		if v >= threshold {
+			return int64(uint64(min) + v%width)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package fair
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommit(t *testing.T) {
+	assert.Equal(t, "2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", Commit([]byte("secret")))
+}
+
+func TestNumber(t *testing.T) {
+	secret := []byte("secret")
+
+	tests := []struct {
+		name string
+		min  int64
+		max  int64
+	}{
+		{name: "Dice", min: 1, max: 6},
+		{name: "Single value", min: 7, max: 7},
+		{name: "Negative", min: -10, max: -5},
+		{name: "Non-negative int64", min: 0, max: math.MaxInt64},
+		{name: "Every int64", min: math.MinInt64, max: math.MaxInt64},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, clientSeed := range []string{"a", "b", "c", "player-42"} {
+				n := Number(secret, clientSeed, tt.min, tt.max)
+				assert.GreaterOrEqual(t, n, tt.min)
+				assert.LessOrEqual(t, n, tt.max)
+				assert.Equal(t, n, Number(secret, clientSeed, tt.min, tt.max), "Expected the same inputs to draw the same number")
+			}
+		})
+	}
+}
+
+func TestNumber_Inputs(t *testing.T) {
+	secret := []byte("secret")
+	n := Number(secret, "seed", 0, math.MaxInt64)
+
+	assert.NotEqual(t, n, Number([]byte("secret2"), "seed", 0, math.MaxInt64), "Expected the secret to change the number")
+	assert.NotEqual(t, n, Number(secret, "seed2", 0, math.MaxInt64), "Expected the client seed to change the number")
+}
+
+func TestNumber_Uniform(t *testing.T) {
+	secret := []byte("secret")
+	counts := make([]int, 6)
+	const draws = 60000
+	for i := 0; i < draws; i++ {
+		counts[Number(secret, strconv.Itoa(i), 0, 5)]++
+	}
+
+	for face, count := range counts {
+		assert.InDelta(t, draws/6, count, draws/6*0.05, "Expected face %d to come up as often as the others", face)
+	}
+}
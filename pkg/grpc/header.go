@@ -7,8 +7,10 @@ import (
 )
 
 var (
-	RequestIDHeader = "x-request-id"
-	ClientIPHeader  = "x-client-ip"
+	RequestIDHeader      = "x-request-id"
+	ClientIPHeader       = "x-client-ip"
+	IdempotencyKeyHeader = "x-idempotency-key"
+	APIKeyHeader         = "x-api-key"
 )
 
 func GetRequestIDFromContext(ctx context.Context) string {
@@ -24,3 +26,45 @@ func GetRequestIDFromContext(ctx context.Context) string {
 
 	return requestIds[0]
 }
+
+func GetClientIPFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	clientIPs := md.Get(ClientIPHeader)
+	if len(clientIPs) == 0 {
+		return ""
+	}
+
+	return clientIPs[0]
+}
+
+func GetIdempotencyKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	keys := md.Get(IdempotencyKeyHeader)
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
+
+func GetAPIKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	keys := md.Get(APIKeyHeader)
+	if len(keys) == 0 {
+		return ""
+	}
+
+	return keys[0]
+}
@@ -7,8 +7,10 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/bufbuild/protovalidate-go"
 	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -17,11 +19,23 @@ var (
 	ErrInvalidSessionId = errors.New("invalid session id")
 	ErrEmailExists      = errors.New("email already exists")
 	ErrNoMetadata       = errors.New("no metadata")
+	ErrExhausted        = errors.New("resource exhausted")
+	ErrExpired          = errors.New("expired")
+	ErrDisabled         = errors.New("disabled")
+	ErrConflict         = errors.New("conflict")
+	ErrUnauthenticated  = errors.New("unauthenticated")
 )
 
-// ParseGRPCErrStatusCode parses error and get code
+// ParseGRPCErrStatusCode parses error and get code, errors that already
+// carry a gRPC status keep its code
 func ParseGRPCErrStatusCode(err error) codes.Code {
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
 	switch {
+	case errors.Is(err, ErrNotFound):
+		return codes.NotFound
 	case errors.Is(err, sql.ErrNoRows):
 		return codes.NotFound
 	case errors.Is(err, redis.Nil):
@@ -34,8 +48,20 @@ func ParseGRPCErrStatusCode(err error) codes.Code {
 		return codes.AlreadyExists
 	case errors.Is(err, ErrNoCtxMetaData):
 		return codes.Unauthenticated
+	case errors.Is(err, ErrUnauthenticated):
+		return codes.Unauthenticated
 	case errors.Is(err, ErrInvalidSessionId):
 		return codes.PermissionDenied
+	case errors.Is(err, ErrExhausted):
+		return codes.ResourceExhausted
+	case errors.Is(err, ErrExpired):
+		return codes.FailedPrecondition
+	case errors.Is(err, ErrDisabled):
+		return codes.FailedPrecondition
+	case errors.Is(err, ErrConflict):
+		return codes.Aborted
+	case errors.As(err, new(*protovalidate.ValidationError)):
+		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "validate"):
 		return codes.InvalidArgument
 	case strings.Contains(err.Error(), "redis"):
@@ -63,6 +89,12 @@ func MapGRPCErrCodeToHttpStatus(code codes.Code) int {
 		return http.StatusGatewayTimeout
 	case codes.InvalidArgument:
 		return http.StatusBadRequest
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		return http.StatusBadRequest
+	case codes.Aborted:
+		return http.StatusConflict
 	}
 	return http.StatusInternalServerError
 }
@@ -0,0 +1,57 @@
+package grpc_errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseGRPCErrStatusCode(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{
+			name: "Sentinel",
+			err:  fmt.Errorf("generator %q: %w", "id", ErrNotFound),
+			want: codes.NotFound,
+		},
+		{
+			name: "Validation",
+			err:  errors.New("validate: count must be positive"),
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Context",
+			err:  context.Canceled,
+			want: codes.Canceled,
+		},
+		{
+			name: "Status",
+			err:  status.Error(codes.NotFound, "generator not found"),
+			want: codes.NotFound,
+		},
+		{
+			name: "Status whose message matches nothing",
+			err:  status.Error(codes.ResourceExhausted, "daily quota of 2 draws exceeded"),
+			want: codes.ResourceExhausted,
+		},
+		{
+			name: "Unknown",
+			err:  errors.New("boom"),
+			want: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseGRPCErrStatusCode(tt.err))
+		})
+	}
+}
@@ -0,0 +1,369 @@
+// Package journal keeps an append-only, hash-chained record of the draws
+// the server produced, so that it can be proven after the fact which values
+// were issued to whom.
+//
+// The journal is a file of JSON entries, one per line. Every entry holds the
+// hash of the entry before it, and its own hash covers that link, so editing,
+// removing or reordering entries breaks the chain from that point on, which
+// Verify detects.
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/log"
+)
+
+// Genesis is the previous hash of the first entry.
+var Genesis = strings.Repeat("0", sha256.Size*2)
+
+// ErrOffset is returned by Scan for offsets that do not start an entry.
+var ErrOffset = errors.New("offset does not start an entry")
+
+// Entry is a draw recorded in the journal.
+type Entry struct {
+	// Sequence numbers entries from 1
+	Sequence  uint64    `json:"sequence"`
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"request_id"`
+	ClientIP  string    `json:"client_ip"`
+	// Method is the gRPC method that produced the draw
+	Method           string `json:"method"`
+	Seed             int64  `json:"seed"`
+	Algorithm        string `json:"algorithm"`
+	AlgorithmVersion string `json:"algorithm_version"`
+	// Output is the reply as compact JSON
+	Output   json.RawMessage `json:"output"`
+	PrevHash string          `json:"prev_hash"`
+	Hash     string          `json:"hash"`
+}
+
+// Bytes returns what the hash of e covers: a version line followed by one
+// "name=value" line per field but the hash, in the order of the struct. The
+// timestamp is written in Unix nanoseconds.
+func (e Entry) Bytes() []byte {
+	var b bytes.Buffer
+	b.WriteString("soa-404 journal v1\n")
+	for _, field := range [][2]string{
+		{"sequence", strconv.FormatUint(e.Sequence, 10)},
+		{"timestamp", strconv.FormatInt(e.Timestamp.UnixNano(), 10)},
+		{"request_id", e.RequestID},
+		{"client_ip", e.ClientIP},
+		{"method", e.Method},
+		{"seed", strconv.FormatInt(e.Seed, 10)},
+		{"algorithm", e.Algorithm},
+		{"algorithm_version", e.AlgorithmVersion},
+		{"output", string(e.Output)},
+		{"prev_hash", e.PrevHash},
+	} {
+		b.WriteString(field[0])
+		b.WriteByte('=')
+		b.WriteString(field[1])
+		b.WriteByte('\n')
+	}
+
+	return b.Bytes()
+}
+
+// ComputeHash returns the hex SHA-256 of the Bytes of e.
+func (e Entry) ComputeHash() string {
+	sum := sha256.Sum256(e.Bytes())
+	return hex.EncodeToString(sum[:])
+}
+
+// Journal appends entries to the journal file from a single goroutine, so
+// that recording a draw never waits for the disk.
+type Journal struct {
+	config  *config.Journal
+	file    *os.File
+	entries chan Entry
+	done    chan struct{}
+
+	// sequence and last are the sequence and hash of the last entry written
+	sequence uint64
+	last     string
+
+	// mu keeps Close from closing entries while Record sends to it
+	mu     sync.RWMutex
+	closed bool
+}
+
+// Open opens the journal file of config, creating it if needed, and
+// continues its chain. A last line cut short by a crash is dropped, as it was
+// never completely written. The journal is a no-op when disabled.
+func Open(config *config.Journal) (*Journal, error) {
+	j := &Journal{
+		config: config,
+		last:   Genesis,
+		done:   make(chan struct{}),
+	}
+	if !config.Enabled {
+		close(j.done)
+		return j, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(config.Path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create journal directory: %w", err)
+	}
+	file, err := os.OpenFile(config.Path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open journal: %w", err)
+	}
+
+	last, end, err := lastEntry(file)
+	if err == nil {
+		err = file.Truncate(end)
+	}
+	if err == nil {
+		_, err = file.Seek(end, io.SeekStart)
+	}
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read journal %s: %w", config.Path, err)
+	}
+	if last != nil {
+		j.sequence = last.Sequence
+		j.last = last.Hash
+	}
+
+	j.file = file
+	j.entries = make(chan Entry, config.BufferSize)
+
+	return j, nil
+}
+
+// lastEntry returns the last complete entry of file, if any, and the offset
+// of the end of its line.
+func lastEntry(file *os.File) (*Entry, int64, error) {
+	reader := bufio.NewReader(file)
+	var line []byte
+	var end int64
+	for {
+		next, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			// Whatever follows the last newline is an unfinished entry
+			break
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		line = next
+		end += int64(len(next))
+	}
+	if line == nil {
+		return nil, 0, nil
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(line, &entry); err != nil {
+		return nil, 0, fmt.Errorf("invalid last entry: %w", err)
+	}
+
+	return &entry, end, nil
+}
+
+// Enabled reports whether draws are recorded.
+func (j *Journal) Enabled() bool {
+	return j.file != nil
+}
+
+// Record queues entry, which is written with the next sequence number and
+// the hash of the previous entry. It only blocks while the buffer is full,
+// and drops the entry once the journal is closed.
+func (j *Journal) Record(entry Entry) {
+	if !j.Enabled() {
+		return
+	}
+
+	j.mu.RLock()
+	defer j.mu.RUnlock()
+	if !j.closed {
+		j.entries <- entry
+	}
+}
+
+// Run writes queued entries until Close, syncing the file whenever the queue
+// is empty. Close waits for it, so it must be running.
+func (j *Journal) Run() {
+	if !j.Enabled() {
+		return
+	}
+	defer close(j.done)
+
+	writer := bufio.NewWriter(j.file)
+	for entry := range j.entries {
+		j.write(writer, entry)
+		if len(j.entries) > 0 {
+			continue
+		}
+
+		err := writer.Flush()
+		if err == nil {
+			err = j.file.Sync()
+		}
+		if err != nil {
+			log.GetLogger().Errorf("failed to write journal %s: %v", j.config.Path, err)
+		}
+	}
+	if err := writer.Flush(); err != nil {
+		log.GetLogger().Errorf("failed to write journal %s: %v", j.config.Path, err)
+	}
+}
+
+func (j *Journal) write(writer *bufio.Writer, entry Entry) {
+	// The output must hash as it is written, compact and with the escapes
+	// of encoding/json
+	output, err := json.Marshal(entry.Output)
+	if err != nil {
+		log.GetLogger().Errorf("invalid output for journal entry %d: %v", j.sequence+1, err)
+		return
+	}
+
+	entry.Sequence = j.sequence + 1
+	entry.Timestamp = entry.Timestamp.UTC()
+	entry.Output = output
+	entry.PrevHash = j.last
+	entry.Hash = entry.ComputeHash()
+
+	line, err := json.Marshal(entry)
+	if err == nil {
+		_, err = writer.Write(append(line, '\n'))
+	}
+	if err != nil {
+		log.GetLogger().Errorf("failed to write journal entry %d: %v", entry.Sequence, err)
+		return
+	}
+
+	j.sequence = entry.Sequence
+	j.last = entry.Hash
+}
+
+// Close writes the queued entries and closes the file, later entries are
+// dropped. Call it once the server has stopped serving draws.
+func (j *Journal) Close() error {
+	if !j.Enabled() {
+		return nil
+	}
+
+	j.mu.Lock()
+	if j.closed {
+		j.mu.Unlock()
+		return nil
+	}
+	j.closed = true
+	close(j.entries)
+	j.mu.Unlock()
+
+	// Run closes done once it wrote the queued entries
+	<-j.done
+	if err := j.file.Sync(); err != nil {
+		j.file.Close()
+		return err
+	}
+
+	return j.file.Close()
+}
+
+// Scan calls fn with the entries written from offset on, which must be 0 or
+// an offset fn was given, until fn returns false. fn gets the offset of the
+// entry after its entry. Entries still being written are not scanned.
+func (j *Journal) Scan(offset int64, fn func(entry Entry, next int64) bool) error {
+	if !j.Enabled() {
+		return errors.New("journal is disabled")
+	}
+
+	file, err := os.Open(j.config.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if offset > 0 {
+		// Offsets fn was given follow a newline
+		var before [1]byte
+		if _, err := file.ReadAt(before[:], offset-1); err != nil || before[0] != '\n' {
+			return fmt.Errorf("%w: %d", ErrOffset, offset)
+		}
+	}
+	if _, err := file.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReader(file)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		offset += int64(len(line))
+
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return fmt.Errorf("invalid entry before offset %d: %w", offset, err)
+		}
+		if !fn(entry, offset) {
+			return nil
+		}
+	}
+}
+
+// Summary describes a verified journal.
+type Summary struct {
+	Entries  uint64
+	LastHash string
+}
+
+// Verify checks the chain of the journal read from r: sequence numbers
+// follow each other from 1, every entry links to the hash of the one before
+// and matches its own hash. It returns an error naming the first line that
+// breaks the chain.
+func Verify(r io.Reader) (Summary, error) {
+	summary := Summary{LastHash: Genesis}
+	reader := bufio.NewReader(r)
+	for line := 1; ; line++ {
+		data, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(data) > 0 {
+				return summary, fmt.Errorf("line %d: incomplete entry", line)
+			}
+			return summary, nil
+		}
+		if err != nil {
+			return summary, err
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			return summary, fmt.Errorf("line %d: invalid entry: %w", line, err)
+		}
+		if entry.Sequence != summary.Entries+1 {
+			return summary, fmt.Errorf("line %d: sequence %d follows %d", line, entry.Sequence, summary.Entries)
+		}
+		if entry.PrevHash != summary.LastHash {
+			return summary, fmt.Errorf("line %d: previous hash %s does not match the hash of the entry before, %s", line, entry.PrevHash, summary.LastHash)
+		}
+		if hash := entry.ComputeHash(); entry.Hash != hash {
+			return summary, fmt.Errorf("line %d: hash %s does not match the content of the entry, %s", line, entry.Hash, hash)
+		}
+
+		summary.Entries = entry.Sequence
+		summary.LastHash = entry.Hash
+	}
+}
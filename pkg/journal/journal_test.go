@@ -0,0 +1,241 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+)
+
+func newTestConfig(t *testing.T) *config.Journal {
+	return &config.Journal{
+		Enabled:    true,
+		Path:       filepath.Join(t.TempDir(), "journal", "draws.log"),
+		BufferSize: 4,
+	}
+}
+
+// record writes entries to the journal of config and closes it.
+func record(t *testing.T, config *config.Journal, entries ...Entry) {
+	j, err := Open(config)
+	assert.NoError(t, err)
+	go j.Run()
+	for _, entry := range entries {
+		j.Record(entry)
+	}
+	assert.NoError(t, j.Close())
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	var entries []Entry
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\n"), "\n") {
+		var entry Entry
+		assert.NoError(t, json.Unmarshal([]byte(line), &entry))
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+func testEntry(i int) Entry {
+	return Entry{
+		Timestamp:        time.Unix(1700000000, int64(i)).In(time.FixedZone("UTC+7", 7*3600)),
+		RequestID:        "request-" + string(rune('a'+i)),
+		ClientIP:         "192.0.2.1",
+		Method:           "/random.RandomService/GetRandNumber",
+		Seed:             int64(42 + i),
+		Algorithm:        "pcg",
+		AlgorithmVersion: "1",
+		Output:           json.RawMessage(`{ "Number": "123", "Note": "<b>" }`),
+	}
+}
+
+func TestJournal_Chain(t *testing.T) {
+	config := newTestConfig(t)
+	var entries []Entry
+	for i := 0; i < 10; i++ {
+		entries = append(entries, testEntry(i))
+	}
+	record(t, config, entries[:6]...)
+	// Reopening continues the chain
+	record(t, config, entries[6:]...)
+
+	written := readEntries(t, config.Path)
+	assert.Len(t, written, 10)
+	prev := Genesis
+	for i, entry := range written {
+		assert.Equal(t, uint64(i+1), entry.Sequence)
+		assert.Equal(t, prev, entry.PrevHash)
+		assert.Equal(t, entries[i].Seed, entry.Seed)
+		assert.True(t, entries[i].Timestamp.Equal(entry.Timestamp))
+		assert.Equal(t, `{"Number":"123","Note":"\u003cb\u003e"}`, string(entry.Output), "Expected the output as written by encoding/json")
+		prev = entry.Hash
+	}
+
+	file, err := os.Open(config.Path)
+	assert.NoError(t, err)
+	defer file.Close()
+	summary, err := Verify(file)
+	assert.NoError(t, err)
+	assert.Equal(t, Summary{Entries: 10, LastHash: prev}, summary)
+}
+
+func TestJournal_TornEntry(t *testing.T) {
+	config := newTestConfig(t)
+	record(t, config, testEntry(0), testEntry(1))
+
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_WRONLY, 0)
+	assert.NoError(t, err)
+	_, err = file.WriteString(`{"sequence":3,"timest`)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	record(t, config, testEntry(2))
+	entries := readEntries(t, config.Path)
+	assert.Len(t, entries, 3)
+	assert.Equal(t, uint64(3), entries[2].Sequence, "Expected the unfinished entry to be dropped")
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+}
+
+func TestJournal_Disabled(t *testing.T) {
+	config := newTestConfig(t)
+	config.Enabled = false
+
+	j, err := Open(config)
+	assert.NoError(t, err)
+	assert.False(t, j.Enabled())
+	go j.Run()
+	j.Record(testEntry(0))
+	assert.NoError(t, j.Close())
+
+	_, err = os.Stat(config.Path)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestJournal_RecordAfterClose(t *testing.T) {
+	config := newTestConfig(t)
+	j, err := Open(config)
+	assert.NoError(t, err)
+	go j.Run()
+	j.Record(testEntry(0))
+	assert.NoError(t, j.Close())
+	assert.NoError(t, j.Close())
+
+	j.Record(testEntry(1))
+	assert.Len(t, readEntries(t, config.Path), 1)
+}
+
+func TestJournal_Scan(t *testing.T) {
+	config := newTestConfig(t)
+	record(t, config, testEntry(0), testEntry(1), testEntry(2))
+	j, err := Open(config)
+	assert.NoError(t, err)
+	defer j.Close()
+	go j.Run()
+
+	// Entries being written are not scanned
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_WRONLY, 0)
+	assert.NoError(t, err)
+	_, err = file.WriteString(`{"sequence":4,"timest`)
+	assert.NoError(t, err)
+	assert.NoError(t, file.Close())
+
+	var sequences []uint64
+	var offsets []int64
+	assert.NoError(t, j.Scan(0, func(entry Entry, next int64) bool {
+		sequences = append(sequences, entry.Sequence)
+		offsets = append(offsets, next)
+		return true
+	}))
+	assert.Equal(t, []uint64{1, 2, 3}, sequences)
+
+	sequences = nil
+	assert.NoError(t, j.Scan(offsets[0], func(entry Entry, next int64) bool {
+		sequences = append(sequences, entry.Sequence)
+		return false
+	}))
+	assert.Equal(t, []uint64{2}, sequences, "Expected the scan to resume after the first entry and stop")
+
+	for _, offset := range []int64{1, offsets[0] + 1, offsets[2] + 1000} {
+		err := j.Scan(offset, func(Entry, int64) bool { return true })
+		assert.ErrorIs(t, err, ErrOffset)
+	}
+
+	config.Enabled = false
+	disabled, err := Open(config)
+	assert.NoError(t, err)
+	assert.Error(t, disabled.Scan(0, func(Entry, int64) bool { return true }))
+}
+
+func TestVerify_Tampering(t *testing.T) {
+	config := newTestConfig(t)
+	record(t, config, testEntry(0), testEntry(1), testEntry(2))
+	data, err := os.ReadFile(config.Path)
+	assert.NoError(t, err)
+	lines := strings.SplitAfter(string(data), "\n")
+
+	tests := []struct {
+		name   string
+		lines  []string
+		expect string
+	}{
+		{
+			name:   "Edited Output",
+			lines:  []string{lines[0], strings.Replace(lines[1], `"Number":"123"`, `"Number":"124"`, 1), lines[2]},
+			expect: "line 2: hash",
+		},
+		{
+			name:   "Edited Client",
+			lines:  []string{strings.Replace(lines[0], "192.0.2.1", "192.0.2.2", 1), lines[1], lines[2]},
+			expect: "line 1: hash",
+		},
+		{
+			name:   "Removed Entry",
+			lines:  []string{lines[0], lines[2]},
+			expect: "line 2: sequence 3 follows 1",
+		},
+		{
+			name:   "Reordered Entries",
+			lines:  []string{lines[1], lines[0], lines[2]},
+			expect: "line 1: sequence 2 follows 0",
+		},
+		{
+			name:   "Truncated Entry",
+			lines:  []string{lines[0], lines[1][:20]},
+			expect: "line 2: incomplete entry",
+		},
+		{
+			name:   "Invalid Entry",
+			lines:  []string{lines[0], "not json\n"},
+			expect: "line 2: invalid entry",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(bytes.NewBufferString(strings.Join(tt.lines, "")))
+			assert.ErrorContains(t, err, tt.expect)
+		})
+	}
+
+	// Rewriting an entry and its hash breaks the link of the next one
+	var entry Entry
+	assert.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	entry.Output = json.RawMessage(`{"Number":"124","Note":"\u003cb\u003e"}`)
+	entry.Hash = entry.ComputeHash()
+	forged, err := json.Marshal(entry)
+	assert.NoError(t, err)
+	summary, err := Verify(bytes.NewBufferString(lines[0] + string(forged) + "\n" + lines[2]))
+	assert.ErrorContains(t, err, "line 3: previous hash")
+	assert.Equal(t, uint64(2), summary.Entries)
+}
@@ -128,3 +128,89 @@ var Grpc_server_msg_sent_total *Metric = &Metric{
 	Type:        Counter,
 	Labels:      []string{"grpc_type", "grpc_service", "grpc_method"},
 }
+
+//
+// List of random service metrics
+//
+
+// random_generator_sessions_active is a gauge metric that measures the number of live generator sessions.
+var Random_generator_sessions_active *Metric = &Metric{
+	Name:        "generator_sessions_active",
+	Description: "Gauge metric that measures the number of live generator sessions.",
+	Subsystem:   Random,
+	Type:        Gauge,
+	Labels:      []string{},
+}
+
+// random_generator_sessions_evicted_total is a counter metric that measures the number of generator sessions closed after being idle for too long.
+var Random_generator_sessions_evicted_total *Metric = &Metric{
+	Name:        "generator_sessions_evicted_total",
+	Description: "Total number of generator sessions closed after being idle for too long.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{},
+}
+
+// random_jobs_queued is a gauge metric that measures the number of Monte Carlo jobs waiting for a worker.
+var Random_jobs_queued *Metric = &Metric{
+	Name:        "jobs_queued",
+	Description: "Gauge metric that measures the number of Monte Carlo jobs waiting for a worker.",
+	Subsystem:   Random,
+	Type:        Gauge,
+	Labels:      []string{},
+}
+
+// random_job_duration_seconds is a histogram metric that measures how long Monte Carlo jobs run in seconds.
+var Random_job_duration_seconds *Metric = &Metric{
+	Name:        "job_duration_seconds",
+	Description: "Histogram metric that measures how long Monte Carlo jobs run in seconds.",
+	Subsystem:   Random,
+	Type:        Histogram,
+	Labels:      []string{"kind", "state"},
+	Buckets:     []float64{.01, .05, .1, .5, 1, 5, 10, 30, 60, 300, 600},
+}
+
+// random_cache_hits_total is a counter metric that measures the number of seeded numbers served from the cache.
+var Random_cache_hits_total *Metric = &Metric{
+	Name:        "cache_hits_total",
+	Description: "Total number of seeded numbers served from the cache.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{"algorithm"},
+}
+
+// random_cache_misses_total is a counter metric that measures the number of seeded numbers generated because they were not cached.
+var Random_cache_misses_total *Metric = &Metric{
+	Name:        "cache_misses_total",
+	Description: "Total number of seeded numbers generated because they were not cached.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{"algorithm"},
+}
+
+// random_cache_fallbacks_total is a counter metric that measures the number of cache operations served from memory because Redis failed.
+var Random_cache_fallbacks_total *Metric = &Metric{
+	Name:        "cache_fallbacks_total",
+	Description: "Total number of cache operations served from memory because Redis failed.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{},
+}
+
+// random_quota_draws_total is a counter metric that measures the number of draws counted against the quotas of clients.
+var Random_quota_draws_total *Metric = &Metric{
+	Name:        "quota_draws_total",
+	Description: "Total number of draws counted against the quotas of clients.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{"client", "tier"},
+}
+
+// random_quota_rejected_total is a counter metric that measures the number of draws rejected because a quota of the client was used up.
+var Random_quota_rejected_total *Metric = &Metric{
+	Name:        "quota_rejected_total",
+	Description: "Total number of draws rejected because a quota of the client was used up.",
+	Subsystem:   Random,
+	Type:        Counter,
+	Labels:      []string{"client", "tier", "window"},
+}
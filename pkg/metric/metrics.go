@@ -10,8 +10,9 @@ import (
 type Subsystem string
 
 const (
-	HTTP Subsystem = "http"
-	GRPC Subsystem = "grpc"
+	HTTP   Subsystem = "http"
+	GRPC   Subsystem = "grpc"
+	Random Subsystem = "random"
 )
 
 type Metric struct {
@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+)
+
+// ClientIP sets the client IP header of incoming calls, which is logged,
+// journaled and identifies clients for quotas. Only trusted gateways may
+// forward the IP of their own clients, the IP of other callers is the address
+// they connect from, whatever header they send.
+type ClientIP struct {
+	trusted []*net.IPNet
+}
+
+// NewClientIP trusts the gateways in the trustedGateways CIDR ranges.
+func NewClientIP(trustedGateways []string) (*ClientIP, error) {
+	c := &ClientIP{}
+	for _, cidr := range trustedGateways {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted gateway %q: %v", cidr, err)
+		}
+		c.trusted = append(c.trusted, ipNet)
+	}
+
+	return c, nil
+}
+
+// Unary Interceptor
+func (c *ClientIP) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(c.withClientIP(ctx), req)
+}
+
+// Stream Interceptor
+func (c *ClientIP) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	stream := grpc_middleware.WrapServerStream(ss)
+	stream.WrappedContext = c.withClientIP(ss.Context())
+
+	return handler(srv, stream)
+}
+
+// withClientIP returns ctx with the client IP header replaced by the IP the
+// peer forwarded if it is trusted, by the IP of the peer otherwise.
+func (c *ClientIP) withClientIP(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	md = md.Copy()
+
+	peerIP := peerIP(ctx)
+	if forwarded := md.Get(grpcUtils.ClientIPHeader); len(forwarded) == 0 || !c.isTrusted(peerIP) {
+		if peerIP == nil {
+			md.Delete(grpcUtils.ClientIPHeader)
+		} else {
+			md.Set(grpcUtils.ClientIPHeader, peerIP.String())
+		}
+	}
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func (c *ClientIP) isTrusted(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range c.trusted {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// peerIP returns the IP the call comes from, nil when it is unknown, e.g. for
+// calls over a pipe.
+func peerIP(ctx context.Context) net.IP {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return nil
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	return net.ParseIP(host)
+}
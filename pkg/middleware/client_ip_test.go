@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
+)
+
+func TestClientIP(t *testing.T) {
+	clientIP, err := NewClientIP([]string{"10.0.0.0/8"})
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		peer     net.Addr
+		pairs    []string
+		expected string
+	}{
+		{
+			name:     "Trusted gateway",
+			peer:     &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 4000},
+			pairs:    []string{grpcUtils.ClientIPHeader, "192.0.2.1"},
+			expected: "192.0.2.1",
+		},
+		{
+			name:     "Trusted gateway without client IP",
+			peer:     &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 4000},
+			expected: "10.0.0.2",
+		},
+		{
+			name:     "Untrusted peer",
+			peer:     &net.TCPAddr{IP: net.ParseIP("198.51.100.7"), Port: 4000},
+			pairs:    []string{grpcUtils.ClientIPHeader, "192.0.2.1"},
+			expected: "198.51.100.7",
+		},
+		{
+			name:     "Unknown peer",
+			pairs:    []string{grpcUtils.ClientIPHeader, "192.0.2.1"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.pairs...))
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, &peer.Peer{Addr: tt.peer})
+			}
+
+			var ip string
+			_, err := clientIP.Unary(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+				ip = grpcUtils.GetClientIPFromContext(ctx)
+				return nil, nil
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, ip)
+		})
+	}
+
+	_, err = NewClientIP([]string{"10.0.0.0"})
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	grpcUtils "github.com/minhthong582000/soa-404/pkg/grpc"
 	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
@@ -49,6 +50,39 @@ func (im *Interceptor) Logger(ctx context.Context, req interface{}, info *grpc.U
 
 	return reply, err
 }
+
+// StreamLogger Interceptor
+func (im *Interceptor) StreamLogger(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	logger := log.GetLogger()
+
+	ctx := ss.Context()
+	start := time.Now()
+	md, _ := metadata.FromIncomingContext(ctx)
+	stream := &monitoredStream{ServerStream: ss}
+	err := handler(srv, stream)
+	if err != nil {
+		logger.With(
+			ctx,
+			"Method", info.FullMethod,
+			"Time", time.Since(start),
+			"Metadata", md,
+			"Received", stream.received,
+			"Sent", stream.sent,
+		).Error(err)
+	} else {
+		logger.With(
+			ctx,
+			"Method", info.FullMethod,
+			"Time", time.Since(start),
+			"Metadata", md,
+			"Received", stream.received,
+			"Sent", stream.sent,
+		).Infof("Success")
+	}
+
+	return err
+}
+
 func (im *Interceptor) Metrics(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 	metr := metric.GetMetric()
 
@@ -83,3 +117,113 @@ func (im *Interceptor) Metrics(ctx context.Context, req interface{}, info *grpc.
 
 	return resp, err
 }
+
+// StreamMetrics Interceptor, messages are counted as they are received and sent
+func (im *Interceptor) StreamMetrics(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	metr := metric.GetMetric()
+
+	startTime := time.Now()
+	serviceName, methodName := grpcUtils.SplitMethodName(info.FullMethod)
+	rpcType := streamType(info)
+
+	// Call
+	err := handler(srv, &monitoredStream{
+		ServerStream: ss,
+		onReceive: func() {
+			if metr.IsMetricExist(metric.Grpc_server_msg_received_total.Name) {
+				_ = metr.Counter(metric.Grpc_server_msg_received_total, 1, rpcType, serviceName, methodName)
+			}
+		},
+		onSend: func() {
+			if metr.IsMetricExist(metric.Grpc_server_msg_sent_total.Name) {
+				_ = metr.Counter(metric.Grpc_server_msg_sent_total, 1, rpcType, serviceName, methodName)
+			}
+		},
+	})
+
+	// Post Call
+	status := http.StatusOK
+	if err != nil {
+		status = grpc_errors.MapGRPCErrCodeToHttpStatus(grpc_errors.ParseGRPCErrStatusCode(err))
+	}
+	statusStr := strconv.Itoa(status)
+	if metr.IsMetricExist(metric.Grpc_server_handled_total.Name) {
+		_ = metr.Counter(metric.Grpc_server_handled_total, 1, rpcType, serviceName, methodName, statusStr)
+	}
+	if metr.IsMetricExist(metric.Grpc_server_handling_seconds.Name) {
+		_ = metr.Histogram(metric.Grpc_server_handling_seconds, time.Since(startTime).Seconds(), rpcType, serviceName, methodName)
+	}
+
+	return err
+}
+
+// Errors Interceptor, attaches the gRPC code of errors returned without a
+// status, so that clients can tell e.g. invalid requests, unknown generators
+// and a full server apart
+func (im *Interceptor) Errors(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	reply, err := handler(ctx, req)
+
+	return reply, statusError(err)
+}
+
+// StreamErrors Interceptor, the stream counterpart of Errors
+func (im *Interceptor) StreamErrors(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusError(handler(srv, ss))
+}
+
+// statusError returns err with the code grpc_errors maps it to. Errors that
+// already have a status, such as a stream ended by a quota, keep it.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Error(grpc_errors.ParseGRPCErrStatusCode(err), err.Error())
+}
+
+// streamType returns the grpc_type label of the stream.
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return string(metric.BidiStream)
+	case info.IsClientStream:
+		return string(metric.ClientStream)
+	}
+
+	return string(metric.ServerStream)
+}
+
+// monitoredStream counts the messages of a stream and reports each of them.
+type monitoredStream struct {
+	grpc.ServerStream
+	received, sent int
+	onReceive      func()
+	onSend         func()
+}
+
+func (s *monitoredStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received++
+		if s.onReceive != nil {
+			s.onReceive()
+		}
+	}
+
+	return err
+}
+
+func (s *monitoredStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent++
+		if s.onSend != nil {
+			s.onSend()
+		}
+	}
+
+	return err
+}
@@ -1,11 +1,14 @@
 package middleware
 
 import (
+	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"strconv"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
@@ -39,58 +42,146 @@ func (m *Middleware) Logger() echo.MiddlewareFunc {
 	})
 }
 
-func (m *Middleware) Metrics() echo.MiddlewareFunc {
-	metr := metric.GetMetric()
+// Metrics records the requests of every route under the path of the route,
+// e.g. `/users/:id`. The routes in skip, such as the route of a gateway
+// recorded with GatewayMetrics, are left out.
+func (m *Middleware) Metrics(skip ...string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			startTime := time.Now()
 			path := c.Path() // contains route path ala `/users/:id`
-
-			// Post Message Received
-			if metr.IsMetricExist(metric.Http_request_inflight.Name) {
-				_ = metr.AddGauge(metric.Http_request_inflight, 1, path)
-				defer func() {
-					_ = metr.AddGauge(metric.Http_request_inflight, -1, path)
-				}()
-			}
-			reqSz := computeApproximateRequestSize(c.Request())
-			if metr.IsMetricExist(metric.Http_request_size_bytes.Name) {
-				_ = metr.Histogram(metric.Http_request_size_bytes, float64(reqSz), path)
+			if slices.Contains(skip, path) {
+				return next(c)
 			}
 
-			// Call
-			err := next(c)
+			var err error
+			observe(path, c.Request(), func() (int, int64) {
+				err = next(c)
+
+				status := c.Response().Status
+				if err != nil {
+					var httpError *echo.HTTPError
+					if errors.As(err, &httpError) {
+						status = httpError.Code
+					}
+					if status == 0 || status == http.StatusOK {
+						status = http.StatusInternalServerError
+					}
+				}
 
-			// Post message sent
+				return status, c.Response().Size
+			})
 
-			// Post call
-			status := c.Response().Status
-			if err != nil {
-				var httpError *echo.HTTPError
-				if errors.As(err, &httpError) {
-					status = httpError.Code
-				}
-				if status == 0 || status == http.StatusOK {
-					status = http.StatusInternalServerError
-				}
-			}
-			statusStr := strconv.Itoa(status)
-			if metr.IsMetricExist(metric.Http_request_total.Name) {
-				_ = metr.Counter(metric.Http_request_total, 1, path, statusStr)
-			}
-			resSz := c.Response().Size
-			if metr.IsMetricExist(metric.Http_response_size_bytes.Name) {
-				_ = metr.Histogram(metric.Http_response_size_bytes, float64(resSz), path)
-			}
-			if metr.IsMetricExist(metric.Http_request_duration_seconds.Name) {
-				_ = metr.Histogram(metric.Http_request_duration_seconds, time.Since(startTime).Seconds(), path)
+			// Errors such as unknown routes are written by the error handler of echo
+			return err
+		}
+	}
+}
+
+// GatewayMetrics returns the options of a grpc-gateway mux recording its
+// requests like Metrics, under the path template of the RPC they call, e.g.
+// `/v1/jobs/{JobID=*}`. Requests calling no RPC are recorded with an empty
+// path, like those matching no echo route.
+func (m *Middleware) GatewayMetrics() []runtime.ServeMuxOption {
+	return []runtime.ServeMuxOption{
+		runtime.WithMiddlewares(func(next runtime.HandlerFunc) runtime.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+				pattern, _ := runtime.HTTPPattern(r.Context())
+				observeResponse(pattern.String(), w, r, func(w http.ResponseWriter) {
+					next(w, r, pathParams)
+				})
 			}
+		}),
+		runtime.WithRoutingErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, code int) {
+			observeResponse("", w, r, func(w http.ResponseWriter) {
+				runtime.DefaultRoutingErrorHandler(ctx, mux, marshaler, w, r, code)
+			})
+		}),
+	}
+}
 
-			return nil
+// observe records a request to path, serve serves it and returns the status
+// and the size of the response.
+func observe(path string, r *http.Request, serve func() (int, int64)) {
+	metr := metric.GetMetric()
+	startTime := time.Now()
+
+	// Post Message Received
+	if metr.IsMetricExist(metric.Http_request_inflight.Name) {
+		_ = metr.AddGauge(metric.Http_request_inflight, 1, path)
+		defer func() {
+			_ = metr.AddGauge(metric.Http_request_inflight, -1, path)
+		}()
+	}
+	reqSz := computeApproximateRequestSize(r)
+	if metr.IsMetricExist(metric.Http_request_size_bytes.Name) {
+		_ = metr.Histogram(metric.Http_request_size_bytes, float64(reqSz), path)
+	}
+
+	// Call
+	status, resSz := serve()
+
+	// Post call
+	statusStr := strconv.Itoa(status)
+	if metr.IsMetricExist(metric.Http_request_total.Name) {
+		_ = metr.Counter(metric.Http_request_total, 1, path, statusStr)
+	}
+	if metr.IsMetricExist(metric.Http_response_size_bytes.Name) {
+		_ = metr.Histogram(metric.Http_response_size_bytes, float64(resSz), path)
+	}
+	if metr.IsMetricExist(metric.Http_request_duration_seconds.Name) {
+		_ = metr.Histogram(metric.Http_request_duration_seconds, time.Since(startTime).Seconds(), path)
+	}
+}
+
+// observeResponse records a request to path served by serve, which writes the
+// response to the writer it is given.
+func observeResponse(path string, w http.ResponseWriter, r *http.Request, serve func(http.ResponseWriter)) {
+	observe(path, r, func() (int, int64) {
+		recorder := &responseRecorder{ResponseWriter: w}
+		serve(recorder)
+		if recorder.status == 0 {
+			recorder.status = http.StatusOK
 		}
+
+		return recorder.status, recorder.size
+	})
+}
+
+// responseRecorder records the status and the size of a response. It flushes
+// like the writer it wraps, for the streams of the gateway.
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	size   int64
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	n, err := r.ResponseWriter.Write(b)
+	r.size += int64(n)
+
+	return n, err
+}
+
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
 	}
 }
 
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
 func computeApproximateRequestSize(r *http.Request) int {
 	s := 0
 	if r.URL != nil {
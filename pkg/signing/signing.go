@@ -0,0 +1,243 @@
+// Package signing signs random outputs with Ed25519 so that third parties
+// can check that a value was produced by the server and not altered on the
+// way, e.g. by the HTTP gateway.
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+// Payload describes a random output, its Bytes are what gets signed.
+type Payload struct {
+	KeyID            string
+	Seed             int64
+	Mode             string
+	Algorithm        string
+	AlgorithmVersion string
+	// Range is the range the output was drawn from, e.g. "[1, 6]", or empty
+	Range     string
+	Output    string
+	Timestamp time.Time
+}
+
+// Bytes returns the canonical encoding of p: a version line followed by one
+// "name=value" line per field, in the order of the struct. The timestamp is
+// written in Unix nanoseconds.
+func (p Payload) Bytes() []byte {
+	var b strings.Builder
+	b.WriteString("soa-404 signed random v1\n")
+	for _, field := range [][2]string{
+		{"key_id", p.KeyID},
+		{"seed", strconv.FormatInt(p.Seed, 10)},
+		{"mode", p.Mode},
+		{"algorithm", p.Algorithm},
+		{"algorithm_version", p.AlgorithmVersion},
+		{"range", p.Range},
+		{"output", p.Output},
+		{"timestamp", strconv.FormatInt(p.Timestamp.UnixNano(), 10)},
+	} {
+		b.WriteString(field[0])
+		b.WriteByte('=')
+		b.WriteString(field[1])
+		b.WriteByte('\n')
+	}
+
+	return []byte(b.String())
+}
+
+// IntRange formats the closed interval [min, max] for Payload.Range.
+func IntRange(min, max int64) string {
+	return fmt.Sprintf("[%d, %d]", min, max)
+}
+
+// FloatRange formats the half-open interval [min, max) for Payload.Range.
+func FloatRange(min, max float64) string {
+	return "[" + Float(min) + ", " + Float(max) + ")"
+}
+
+// Float formats f for Payload, as the shortest representation that parses
+// back to f.
+func Float(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// Message formats reply for Payload.Output: the base64 of its deterministic
+// protobuf encoding with its Signature field cleared, so that the output
+// covers the whole reply but the signature itself.
+func Message(reply proto.Message) (string, error) {
+	reply = proto.Clone(reply)
+	m := reply.ProtoReflect()
+	if field := m.Descriptor().Fields().ByName("Signature"); field != nil {
+		m.Clear(field)
+	}
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(reply)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Verify reports whether signature is the signature of p by publicKey.
+func Verify(publicKey ed25519.PublicKey, p Payload, signature []byte) bool {
+	if len(publicKey) != ed25519.PublicKeySize {
+		return false
+	}
+
+	return ed25519.Verify(publicKey, p.Bytes(), signature)
+}
+
+// Signer signs payloads with the active key. Retired keys stay configured so
+// that their public keys can still be served, they only need a public key.
+type Signer struct {
+	active string
+	keys   map[string]key
+}
+
+type key struct {
+	private ed25519.PrivateKey
+	public  ed25519.PublicKey
+}
+
+// NewSigner loads the keys of config. A config without an active key
+// disables signing.
+func NewSigner(config *config.Signing) (*Signer, error) {
+	s := &Signer{
+		active: config.ActiveKeyID,
+		keys:   make(map[string]key, len(config.Keys)),
+	}
+
+	for _, k := range config.Keys {
+		if _, ok := s.keys[k.ID]; ok {
+			return nil, fmt.Errorf("signing key %q is configured twice", k.ID)
+		}
+
+		var loaded key
+		var err error
+		if k.PrivateKeyFile != "" {
+			loaded.private, err = loadPrivateKey(k.PrivateKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("signing key %q: %w", k.ID, err)
+			}
+			loaded.public = loaded.private.Public().(ed25519.PublicKey)
+		} else {
+			loaded.public, err = loadPublicKey(k.PublicKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("signing key %q: %w", k.ID, err)
+			}
+		}
+		s.keys[k.ID] = loaded
+	}
+
+	if s.active != "" && s.keys[s.active].private == nil {
+		return nil, fmt.Errorf("active signing key %q needs a private key", s.active)
+	}
+
+	return s, nil
+}
+
+// Enabled reports whether s has an active key.
+func (s *Signer) Enabled() bool {
+	return s != nil && s.active != ""
+}
+
+// Sign sets the key ID of p to the active key and signs it.
+func (s *Signer) Sign(p Payload) (Payload, []byte, error) {
+	if !s.Enabled() {
+		return p, nil, errors.New("signing is disabled")
+	}
+
+	p.KeyID = s.active
+	return p, ed25519.Sign(s.keys[s.active].private, p.Bytes()), nil
+}
+
+// PublicKey returns the public key id, an empty id is the active key.
+func (s *Signer) PublicKey(id string) (string, ed25519.PublicKey, error) {
+	if id == "" {
+		if !s.Enabled() {
+			return "", nil, fmt.Errorf("signing is disabled, no key is active: %w", grpc_errors.ErrNotFound)
+		}
+		id = s.active
+	}
+
+	k, ok := s.keys[id]
+	if !ok {
+		return "", nil, fmt.Errorf("signing key %q: %w", id, grpc_errors.ErrNotFound)
+	}
+
+	return id, k.public, nil
+}
+
+// ActiveKeyID returns the ID of the key that signs new payloads.
+func (s *Signer) ActiveKeyID() string {
+	return s.active
+}
+
+// loadPrivateKey reads a PEM encoded PKCS #8 Ed25519 private key, as written
+// by `openssl genpkey -algorithm ed25519`.
+func loadPrivateKey(path string) (ed25519.PrivateKey, error) {
+	der, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	private, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Ed25519 private key", path)
+	}
+
+	return private, nil
+}
+
+// loadPublicKey reads a PEM encoded PKIX Ed25519 public key, as written by
+// `openssl pkey -pubout`.
+func loadPublicKey(path string) (ed25519.PublicKey, error) {
+	der, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	public, ok := parsed.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an Ed25519 public key", path)
+	}
+
+	return public, nil
+}
+
+func readPEM(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not PEM encoded", path)
+	}
+
+	return block.Bytes, nil
+}
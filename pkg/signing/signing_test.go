@@ -0,0 +1,171 @@
+package signing
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	pb "github.com/minhthong582000/soa-404/api/v1/pb/random"
+	"github.com/minhthong582000/soa-404/pkg/config"
+	"github.com/minhthong582000/soa-404/pkg/grpc_errors"
+)
+
+// writeKey writes a new key pair to dir and returns the paths of the private
+// and public key files.
+func writeKey(t *testing.T, dir string, name string) (string, string) {
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(private)
+	assert.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(public)
+	assert.NoError(t, err)
+
+	privatePath := filepath.Join(dir, name+".pem")
+	publicPath := filepath.Join(dir, name+".pub.pem")
+	assert.NoError(t, os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600))
+	assert.NoError(t, os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o600))
+
+	return privatePath, publicPath
+}
+
+func TestSigner_SignVerify(t *testing.T) {
+	dir := t.TempDir()
+	privatePath, _ := writeKey(t, dir, "a")
+
+	signer, err := NewSigner(&config.Signing{
+		ActiveKeyID: "a",
+		Keys:        []config.SigningKey{{ID: "a", PrivateKeyFile: privatePath}},
+	})
+	assert.NoError(t, err)
+	assert.True(t, signer.Enabled())
+
+	payload, signature, err := signer.Sign(Payload{
+		Seed:             42,
+		Mode:             "seeded",
+		Algorithm:        "pcg",
+		AlgorithmVersion: "1",
+		Output:           "123",
+		Timestamp:        time.Unix(0, 1234567890),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "a", payload.KeyID)
+
+	id, public, err := signer.PublicKey("")
+	assert.NoError(t, err)
+	assert.Equal(t, "a", id)
+	assert.True(t, Verify(public, payload, signature))
+
+	tampered := payload
+	tampered.Output = "124"
+	assert.False(t, Verify(public, tampered, signature), "Expected a different output to fail verification")
+	tampered = payload
+	tampered.Timestamp = tampered.Timestamp.Add(time.Nanosecond)
+	assert.False(t, Verify(public, tampered, signature), "Expected a different timestamp to fail verification")
+	assert.False(t, Verify(public[:10], payload, signature))
+}
+
+func TestMessage(t *testing.T) {
+	reply := &pb.NextReply{Numbers: []int64{4, 8, 15}, Index: 3}
+	output, err := Message(reply)
+	assert.NoError(t, err)
+
+	reply.Signature = &pb.Signature{KeyID: "a", Value: []byte{1, 2, 3}}
+	signed, err := Message(reply)
+	assert.NoError(t, err)
+	assert.Equal(t, output, signed, "Expected the signature not to be part of the output")
+	assert.NotNil(t, reply.Signature, "Expected the reply to be left unchanged")
+
+	reply.Numbers[2]++
+	altered, err := Message(reply)
+	assert.NoError(t, err)
+	assert.NotEqual(t, output, altered)
+}
+
+func TestSigner_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	_, oldPublicPath := writeKey(t, dir, "old")
+	newPrivatePath, _ := writeKey(t, dir, "new")
+
+	signer, err := NewSigner(&config.Signing{
+		ActiveKeyID: "new",
+		Keys: []config.SigningKey{
+			{ID: "old", PublicKeyFile: oldPublicPath},
+			{ID: "new", PrivateKeyFile: newPrivatePath},
+		},
+	})
+	assert.NoError(t, err)
+
+	payload, _, err := signer.Sign(Payload{Output: "1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "new", payload.KeyID)
+
+	id, public, err := signer.PublicKey("old")
+	assert.NoError(t, err)
+	assert.Equal(t, "old", id)
+	assert.Len(t, public, ed25519.PublicKeySize)
+
+	_, _, err = signer.PublicKey("missing")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+
+	_, err = NewSigner(&config.Signing{
+		ActiveKeyID: "old",
+		Keys:        []config.SigningKey{{ID: "old", PublicKeyFile: oldPublicPath}},
+	})
+	assert.ErrorContains(t, err, "needs a private key", "Expected a retired key not to be active")
+
+	_, err = NewSigner(&config.Signing{
+		Keys: []config.SigningKey{
+			{ID: "old", PublicKeyFile: oldPublicPath},
+			{ID: "old", PrivateKeyFile: newPrivatePath},
+		},
+	})
+	assert.ErrorContains(t, err, "configured twice")
+}
+
+func TestSigner_Disabled(t *testing.T) {
+	signer, err := NewSigner(&config.Signing{})
+	assert.NoError(t, err)
+	assert.False(t, signer.Enabled())
+
+	_, _, err = signer.Sign(Payload{})
+	assert.Error(t, err)
+	_, _, err = signer.PublicKey("")
+	assert.ErrorIs(t, err, grpc_errors.ErrNotFound)
+}
+
+func TestNewSigner_InvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+	_, publicPath := writeKey(t, dir, "a")
+	garbage := filepath.Join(dir, "garbage.pem")
+	assert.NoError(t, os.WriteFile(garbage, []byte("not a key"), 0o600))
+
+	tests := []struct {
+		name string
+		key  config.SigningKey
+	}{
+		{name: "Missing file", key: config.SigningKey{ID: "a", PrivateKeyFile: filepath.Join(dir, "missing.pem")}},
+		{name: "Not PEM", key: config.SigningKey{ID: "a", PrivateKeyFile: garbage}},
+		{name: "Public key as private key", key: config.SigningKey{ID: "a", PrivateKeyFile: publicPath}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSigner(&config.Signing{Keys: []config.SigningKey{tt.key}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRanges(t *testing.T) {
+	assert.Equal(t, "[1, 6]", IntRange(1, 6))
+	assert.Equal(t, "[0, 1.5)", FloatRange(0, 1.5))
+	assert.Equal(t, "0.1", Float(0.1))
+}